@@ -0,0 +1,51 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSDbClusterSnapshotDataSource_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAwsDbClusterSnapshotDataSourceConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDbSnapshotDataSourceID("data.aws_db_cluster_snapshot.snapshot"),
+					resource.TestCheckResourceAttrPair("data.aws_db_cluster_snapshot.snapshot", "db_cluster_snapshot_arn", "aws_db_cluster_snapshot.test", "db_cluster_snapshot_arn"),
+					resource.TestCheckResourceAttrSet("data.aws_db_cluster_snapshot.snapshot", "snapshot_create_time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDbClusterSnapshotDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_rds_cluster" "test" {
+  cluster_identifier  = "tf-aurora-cluster-%d"
+  availability_zones  = ["us-west-2a","us-west-2b","us-west-2c"]
+  database_name       = "mydb"
+  master_username     = "foo"
+  master_password     = "mustbeeightcharaters"
+  skip_final_snapshot = true
+}
+
+resource "aws_db_cluster_snapshot" "test" {
+  db_cluster_identifier          = "${aws_rds_cluster.test.id}"
+  db_cluster_snapshot_identifier = "tf-aurora-snapshot-%d"
+}
+
+data "aws_db_cluster_snapshot" "snapshot" {
+  most_recent           = true
+  db_cluster_identifier = "${aws_db_cluster_snapshot.test.db_cluster_identifier}"
+  snapshot_type         = "manual"
+}
+`, rInt, rInt)
+}
@@ -116,6 +116,10 @@ func dataSourceAwsNetworkInterface() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"requester_managed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"subnet_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -155,8 +159,7 @@ func dataSourceAwsNetworkInterfaceRead(d *schema.ResourceData, meta interface{})
 		d.Set("association", flattenEc2NetworkInterfaceAssociation(eni.Association))
 	}
 	if eni.Attachment != nil {
-		attachment := []interface{}{flattenAttachment(eni.Attachment)}
-		d.Set("attachment", attachment)
+		d.Set("attachment", flattenEc2NetworkInterfaceAttachment(eni.Attachment))
 	}
 	d.Set("availability_zone", eni.AvailabilityZone)
 	d.Set("description", eni.Description)
@@ -166,9 +169,10 @@ func dataSourceAwsNetworkInterfaceRead(d *schema.ResourceData, meta interface{})
 	d.Set("mac_address", eni.MacAddress)
 	d.Set("owner_id", eni.OwnerId)
 	d.Set("private_dns_name", eni.PrivateDnsName)
-	d.Set("private_id", eni.PrivateIpAddress)
+	d.Set("private_ip", eni.PrivateIpAddress)
 	d.Set("private_ips", flattenNetworkInterfacesPrivateIPAddresses(eni.PrivateIpAddresses))
 	d.Set("requester_id", eni.RequesterId)
+	d.Set("requester_managed", eni.RequesterManaged)
 	d.Set("subnet_id", eni.SubnetId)
 	d.Set("vpc_id", eni.VpcId)
 	d.Set("tags", tagsToMap(eni.TagSet))
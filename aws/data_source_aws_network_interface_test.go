@@ -19,6 +19,9 @@ func TestAccDataSourceAwsNetworkInterface_basic(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("data.aws_network_interface.test", "private_ips.#", "1"),
 					resource.TestCheckResourceAttr("data.aws_network_interface.test", "security_groups.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_network_interface.test", "private_ip", "10.0.0.50"),
+					resource.TestCheckResourceAttr("data.aws_network_interface.test", "requester_managed", "false"),
+					resource.TestCheckResourceAttr("data.aws_network_interface.test", "attachment.#", "0"),
 				),
 			},
 		},
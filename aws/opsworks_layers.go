@@ -29,6 +29,7 @@ type opsworksLayerTypeAttribute struct {
 	Default   interface{}
 	Required  bool
 	WriteOnly bool
+	ForceNew  bool
 }
 
 type opsworksLayerType struct {
@@ -226,6 +227,7 @@ func (lt *opsworksLayerType) SchemaResource() *schema.Resource {
 			Default:  def.Default,
 			Required: def.Required,
 			Optional: !def.Required,
+			ForceNew: def.ForceNew,
 		}
 	}
 
@@ -356,6 +358,18 @@ func (lt *opsworksLayerType) Create(d *schema.ResourceData, client *opsworks.Ops
 
 	req.CustomJson = aws.String(d.Get("custom_json").(string))
 
+	// ECS clusters have to be registered with the stack before a layer can use them
+	if lt.TypeName == opsworks.LayerTypeEcsCluster {
+		log.Printf("[DEBUG] Registering ECS cluster %s with OpsWorks stack %s", d.Get("ecs_cluster_arn"), d.Get("stack_id"))
+		_, err := client.RegisterEcsCluster(&opsworks.RegisterEcsClusterInput{
+			EcsClusterArn: aws.String(d.Get("ecs_cluster_arn").(string)),
+			StackId:       aws.String(d.Get("stack_id").(string)),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	log.Printf("[DEBUG] Creating OpsWorks layer: %s", d.Id())
 
 	resp, err := client.CreateLayer(req)
@@ -455,7 +469,21 @@ func (lt *opsworksLayerType) Delete(d *schema.ResourceData, client *opsworks.Ops
 	log.Printf("[DEBUG] Deleting OpsWorks layer: %s", d.Id())
 
 	_, err := client.DeleteLayer(req)
-	return err
+	if err != nil {
+		return err
+	}
+
+	if lt.TypeName == opsworks.LayerTypeEcsCluster {
+		log.Printf("[DEBUG] Deregistering ECS cluster: %s", d.Get("ecs_cluster_arn"))
+		_, err = client.DeregisterEcsCluster(&opsworks.DeregisterEcsClusterInput{
+			EcsClusterArn: aws.String(d.Get("ecs_cluster_arn").(string)),
+		})
+		if err != nil && !isAWSErr(err, opsworks.ErrCodeResourceNotFoundException, "") {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (lt *opsworksLayerType) AttributeMap(d *schema.ResourceData) map[string]*string {
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"strings"
 )
 
@@ -48,6 +49,21 @@ func resourceAwsApiGatewayIntegration() *schema.Resource {
 				ValidateFunc: validateApiGatewayIntegrationType,
 			},
 
+			"connection_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  apigateway.ConnectionTypeInternet,
+				ValidateFunc: validation.StringInSlice([]string{
+					apigateway.ConnectionTypeInternet,
+					apigateway.ConnectionTypeVpcLink,
+				}, false),
+			},
+
+			"connection_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
 			"uri": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -167,6 +183,15 @@ func resourceAwsApiGatewayIntegrationCreate(d *schema.ResourceData, meta interfa
 		cacheKeyParameters = expandStringList(v.(*schema.Set).List())
 	}
 
+	connectionType := aws.String(d.Get("connection_type").(string))
+	var connectionId *string
+	if *connectionType == apigateway.ConnectionTypeVpcLink {
+		if _, ok := d.GetOk("connection_id"); !ok {
+			return fmt.Errorf("connection_id required when connection_type set to %s", apigateway.ConnectionTypeVpcLink)
+		}
+		connectionId = aws.String(d.Get("connection_id").(string))
+	}
+
 	var cacheNamespace *string
 	if cacheKeyParameters != nil {
 		// Use resource_id unless user provides a custom name
@@ -190,6 +215,8 @@ func resourceAwsApiGatewayIntegrationCreate(d *schema.ResourceData, meta interfa
 		CacheKeyParameters:  cacheKeyParameters,
 		PassthroughBehavior: passthroughBehavior,
 		ContentHandling:     contentHandling,
+		ConnectionType:      connectionType,
+		ConnectionId:        connectionId,
 	})
 	if err != nil {
 		return fmt.Errorf("Error creating API Gateway Integration: %s", err)
@@ -231,6 +258,13 @@ func resourceAwsApiGatewayIntegrationRead(d *schema.ResourceData, meta interface
 	d.Set("request_parameters_in_json", aws.StringValueMap(integration.RequestParameters))
 	d.Set("passthrough_behavior", integration.PassthroughBehavior)
 
+	if integration.ConnectionType != nil {
+		d.Set("connection_type", integration.ConnectionType)
+	} else {
+		d.Set("connection_type", apigateway.ConnectionTypeInternet)
+	}
+	d.Set("connection_id", integration.ConnectionId)
+
 	if integration.Uri != nil {
 		d.Set("uri", integration.Uri)
 	}
@@ -369,6 +403,22 @@ func resourceAwsApiGatewayIntegrationUpdate(d *schema.ResourceData, meta interfa
 		})
 	}
 
+	if d.HasChange("connection_type") {
+		operations = append(operations, &apigateway.PatchOperation{
+			Op:    aws.String("replace"),
+			Path:  aws.String("/connectionType"),
+			Value: aws.String(d.Get("connection_type").(string)),
+		})
+	}
+
+	if d.HasChange("connection_id") {
+		operations = append(operations, &apigateway.PatchOperation{
+			Op:    aws.String("replace"),
+			Path:  aws.String("/connectionId"),
+			Value: aws.String(d.Get("connection_id").(string)),
+		})
+	}
+
 	// The documentation https://docs.aws.amazon.com/apigateway/api-reference/link-relation/integration-update/ says
 	// that uri changes are only supported for non-mock types. Because the uri value is not used in mock
 	// resources, it means that the uri can always be updated
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -146,6 +147,35 @@ func TestAccAWSAPIGatewayIntegration_cache_key_parameters(t *testing.T) {
 	})
 }
 
+func TestAccAWSAPIGatewayIntegration_vpcLink(t *testing.T) {
+	var conf apigateway.Integration
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayIntegrationConfig_IntegrationTypeInternet(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayIntegrationExists("aws_api_gateway_integration.test", &conf),
+					resource.TestCheckResourceAttr("aws_api_gateway_integration.test", "connection_type", "INTERNET"),
+					resource.TestCheckResourceAttr("aws_api_gateway_integration.test", "connection_id", ""),
+				),
+			},
+			{
+				Config: testAccAWSAPIGatewayIntegrationConfig_IntegrationTypeVpcLink(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayIntegrationExists("aws_api_gateway_integration.test", &conf),
+					resource.TestCheckResourceAttr("aws_api_gateway_integration.test", "connection_type", "VPC_LINK"),
+					resource.TestCheckResourceAttrPair("aws_api_gateway_integration.test", "connection_id", "aws_api_gateway_vpc_link.test", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSAPIGatewayIntegrationExists(n string, res *apigateway.Integration) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -430,3 +460,80 @@ resource "aws_api_gateway_integration" "test" {
   content_handling = "CONVERT_TO_TEXT"
 }
 `
+
+func testAccAWSAPIGatewayIntegrationConfig_IntegrationTypeBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.10.0.0/16"
+
+  tags {
+    Name = "%s"
+  }
+}
+
+data "aws_availability_zones" "test" {}
+
+resource "aws_subnet" "test" {
+  vpc_id            = "${aws_vpc.test.id}"
+  cidr_block        = "10.10.0.0/24"
+  availability_zone = "${data.aws_availability_zones.test.names[0]}"
+}
+
+resource "aws_lb" "test" {
+  name               = "%s"
+  internal           = true
+  load_balancer_type = "network"
+  subnets            = ["${aws_subnet.test.id}"]
+}
+
+resource "aws_api_gateway_vpc_link" "test" {
+  name        = "%s"
+  target_arns = ["${aws_lb.test.arn}"]
+}
+
+resource "aws_api_gateway_rest_api" "test" {
+  name = "%s"
+}
+
+resource "aws_api_gateway_resource" "test" {
+  rest_api_id = "${aws_api_gateway_rest_api.test.id}"
+  parent_id   = "${aws_api_gateway_rest_api.test.root_resource_id}"
+  path_part   = "test"
+}
+
+resource "aws_api_gateway_method" "test" {
+  rest_api_id   = "${aws_api_gateway_rest_api.test.id}"
+  resource_id   = "${aws_api_gateway_resource.test.id}"
+  http_method   = "GET"
+  authorization = "NONE"
+}
+`, rName, rName, rName, rName)
+}
+
+func testAccAWSAPIGatewayIntegrationConfig_IntegrationTypeInternet(rName string) string {
+	return testAccAWSAPIGatewayIntegrationConfig_IntegrationTypeBase(rName) + `
+resource "aws_api_gateway_integration" "test" {
+  rest_api_id             = "${aws_api_gateway_rest_api.test.id}"
+  resource_id             = "${aws_api_gateway_resource.test.id}"
+  http_method             = "${aws_api_gateway_method.test.http_method}"
+  type                    = "HTTP"
+  uri                     = "https://www.google.de"
+  integration_http_method = "GET"
+}
+`
+}
+
+func testAccAWSAPIGatewayIntegrationConfig_IntegrationTypeVpcLink(rName string) string {
+	return testAccAWSAPIGatewayIntegrationConfig_IntegrationTypeBase(rName) + `
+resource "aws_api_gateway_integration" "test" {
+  rest_api_id             = "${aws_api_gateway_rest_api.test.id}"
+  resource_id             = "${aws_api_gateway_resource.test.id}"
+  http_method             = "${aws_api_gateway_method.test.http_method}"
+  type                    = "HTTP"
+  uri                     = "https://www.google.de"
+  integration_http_method = "GET"
+  connection_type         = "VPC_LINK"
+  connection_id           = "${aws_api_gateway_vpc_link.test.id}"
+}
+`
+}
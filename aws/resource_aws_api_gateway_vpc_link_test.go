@@ -0,0 +1,140 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAPIGatewayVpcLink_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resourceName := "aws_api_gateway_vpc_link.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAPIGatewayVpcLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIGatewayVpcLinkConfig(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAPIGatewayVpcLinkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("tf-apigateway-%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "target_arns.#", "1"),
+				),
+			},
+			{
+				Config: testAccAPIGatewayVpcLinkConfig_Update(rName, "test update"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAPIGatewayVpcLinkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("tf-apigateway-update-%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", "test update"),
+					resource.TestCheckResourceAttr(resourceName, "target_arns.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsAPIGatewayVpcLinkDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).apigateway
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_api_gateway_vpc_link" {
+			continue
+		}
+
+		_, err := conn.GetVpcLink(&apigateway.GetVpcLinkInput{
+			VpcLinkId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, apigateway.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("API Gateway VPC Link %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsAPIGatewayVpcLinkExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No API Gateway VPC Link ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).apigateway
+
+		_, err := conn.GetVpcLink(&apigateway.GetVpcLinkInput{
+			VpcLinkId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccAPIGatewayVpcLinkConfig_basis(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lb" "test_a" {
+  name               = "tf-lb-%s"
+  internal           = true
+  load_balancer_type = "network"
+  subnets            = ["${aws_subnet.test.id}"]
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.10.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-apigateway-vpc-link"
+  }
+}
+
+data "aws_availability_zones" "test" {}
+
+resource "aws_subnet" "test" {
+  vpc_id            = "${aws_vpc.test.id}"
+  cidr_block        = "10.10.0.0/21"
+  availability_zone = "${data.aws_availability_zones.test.names[0]}"
+}
+`, rName)
+}
+
+func testAccAPIGatewayVpcLinkConfig(rName, description string) string {
+	return testAccAPIGatewayVpcLinkConfig_basis(rName) + fmt.Sprintf(`
+resource "aws_api_gateway_vpc_link" "test" {
+  name        = "tf-apigateway-%s"
+  description = "%s"
+  target_arns = ["${aws_lb.test_a.arn}"]
+}
+`, rName, description)
+}
+
+func testAccAPIGatewayVpcLinkConfig_Update(rName, description string) string {
+	return testAccAPIGatewayVpcLinkConfig_basis(rName) + fmt.Sprintf(`
+resource "aws_api_gateway_vpc_link" "test" {
+  name        = "tf-apigateway-update-%s"
+  description = "%s"
+  target_arns = ["${aws_lb.test_a.arn}"]
+}
+`, rName, description)
+}
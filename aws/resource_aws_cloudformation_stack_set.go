@@ -0,0 +1,323 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsCloudFormationStackSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudFormationStackSetCreate,
+		Read:   resourceAwsCloudFormationStackSetRead,
+		Update: resourceAwsCloudFormationStackSetUpdate,
+		Delete: resourceAwsCloudFormationStackSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"template_body": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"template_url"},
+				ValidateFunc:  validateCloudFormationTemplate,
+				StateFunc: func(v interface{}) string {
+					template, _ := normalizeCloudFormationTemplate(v)
+					return template
+				},
+			},
+			"template_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"template_body"},
+			},
+			"capabilities": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"stack_set_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCloudFormationStackSetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	name := d.Get("name").(string)
+	input := &cloudformation.CreateStackSetInput{
+		StackSetName: aws.String(name),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("template_body"); ok {
+		template, err := normalizeCloudFormationTemplate(v)
+		if err != nil {
+			return errwrap.Wrapf("template body contains an invalid JSON or YAML: {{err}}", err)
+		}
+		input.TemplateBody = aws.String(template)
+	}
+	if v, ok := d.GetOk("template_url"); ok {
+		input.TemplateURL = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("capabilities"); ok {
+		input.Capabilities = expandStringList(v.(*schema.Set).List())
+	}
+	if v, ok := d.GetOk("parameters"); ok {
+		input.Parameters = expandCloudFormationParameters(v.(map[string]interface{}))
+	}
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = expandCloudFormationTags(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating CloudFormation Stack Set: %s", input)
+	_, err := conn.CreateStackSet(input)
+	if err != nil {
+		return fmt.Errorf("Error creating CloudFormation Stack Set %q: %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsCloudFormationStackSetRead(d, meta)
+}
+
+func resourceAwsCloudFormationStackSetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	resp, err := conn.DescribeStackSet(&cloudformation.DescribeStackSetInput{
+		StackSetName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+			log.Printf("[WARN] CloudFormation Stack Set (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading CloudFormation Stack Set (%s): %s", d.Id(), err)
+	}
+
+	stackSet := resp.StackSet
+	if aws.StringValue(stackSet.Status) == cloudformation.StackSetStatusDeleted {
+		log.Printf("[WARN] CloudFormation Stack Set (%s) deleted, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", stackSet.StackSetName)
+	d.Set("description", stackSet.Description)
+	d.Set("stack_set_id", stackSet.StackSetId)
+
+	template, err := normalizeCloudFormationTemplate(aws.StringValue(stackSet.TemplateBody))
+	if err != nil {
+		return errwrap.Wrapf("template body contains an invalid JSON or YAML: {{err}}", err)
+	}
+	d.Set("template_body", template)
+
+	if err := d.Set("capabilities", flattenStringList(stackSet.Capabilities)); err != nil {
+		return fmt.Errorf("Error setting capabilities: %s", err)
+	}
+
+	originalParams := d.Get("parameters").(map[string]interface{})
+	if err := d.Set("parameters", flattenCloudFormationParameters(stackSet.Parameters, originalParams)); err != nil {
+		return fmt.Errorf("Error setting parameters: %s", err)
+	}
+
+	if err := d.Set("tags", flattenCloudFormationTags(stackSet.Tags)); err != nil {
+		return fmt.Errorf("Error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCloudFormationStackSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	input := &cloudformation.UpdateStackSetInput{
+		StackSetName: aws.String(d.Id()),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	// Either a template or UsePreviousTemplate must be supplied.
+	if d.HasChange("template_url") && d.Get("template_url").(string) != "" {
+		input.TemplateURL = aws.String(d.Get("template_url").(string))
+	} else if d.HasChange("template_body") {
+		template, err := normalizeCloudFormationTemplate(d.Get("template_body"))
+		if err != nil {
+			return errwrap.Wrapf("template body contains an invalid JSON or YAML: {{err}}", err)
+		}
+		input.TemplateBody = aws.String(template)
+	} else {
+		input.UsePreviousTemplate = aws.Bool(true)
+	}
+
+	if v, ok := d.GetOk("capabilities"); ok {
+		input.Capabilities = expandStringList(v.(*schema.Set).List())
+	}
+	if v, ok := d.GetOk("parameters"); ok {
+		input.Parameters = expandCloudFormationParameters(v.(map[string]interface{}))
+	}
+	// Omitting tags leaves the existing tags untouched, so an empty list is
+	// sent to clear them.
+	input.Tags = expandCloudFormationTags(d.Get("tags").(map[string]interface{}))
+	if input.Tags == nil {
+		input.Tags = []*cloudformation.Tag{}
+	}
+
+	log.Printf("[DEBUG] Updating CloudFormation Stack Set: %s", input)
+	var resp *cloudformation.UpdateStackSetOutput
+	err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		var err error
+		resp, err = conn.UpdateStackSet(input)
+		if isAWSErr(err, cloudformation.ErrCodeOperationInProgressException, "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating CloudFormation Stack Set (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForCloudFormationStackSetOperation(conn, d.Id(), aws.StringValue(resp.OperationId), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("Error waiting for CloudFormation Stack Set (%s) update: %s", d.Id(), err)
+	}
+
+	return resourceAwsCloudFormationStackSetRead(d, meta)
+}
+
+func resourceAwsCloudFormationStackSetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	log.Printf("[DEBUG] Deleting CloudFormation Stack Set: %s", d.Id())
+	_, err := conn.DeleteStackSet(&cloudformation.DeleteStackSetInput{
+		StackSetName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting CloudFormation Stack Set (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// waitForCloudFormationStackSetOperation waits for a stack set operation to
+// complete, returning the per-instance failure reasons if it does not succeed.
+func waitForCloudFormationStackSetOperation(conn *cloudformation.CloudFormation, stackSetName, operationId string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			cloudformation.StackSetOperationStatusRunning,
+			cloudformation.StackSetOperationStatusStopping,
+		},
+		Target: []string{
+			cloudformation.StackSetOperationStatusSucceeded,
+			cloudformation.StackSetOperationStatusFailed,
+			cloudformation.StackSetOperationStatusStopped,
+		},
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			resp, err := conn.DescribeStackSetOperation(&cloudformation.DescribeStackSetOperationInput{
+				OperationId:  aws.String(operationId),
+				StackSetName: aws.String(stackSetName),
+			})
+			if err != nil {
+				return nil, "", err
+			}
+
+			status := aws.StringValue(resp.StackSetOperation.Status)
+			log.Printf("[DEBUG] CloudFormation Stack Set (%s) operation %s status: %s", stackSetName, operationId, status)
+			return resp, status, nil
+		},
+	}
+
+	raw, err := stateConf.WaitForState()
+	if err != nil {
+		return err
+	}
+
+	status := aws.StringValue(raw.(*cloudformation.DescribeStackSetOperationOutput).StackSetOperation.Status)
+	if status == cloudformation.StackSetOperationStatusSucceeded {
+		return nil
+	}
+
+	reasons, err := getCloudFormationStackSetOperationFailures(conn, stackSetName, operationId)
+	if err != nil {
+		return fmt.Errorf("operation %s %s, failed getting failure reasons: %s", operationId, status, err)
+	}
+
+	return fmt.Errorf("operation %s %s: %s", operationId, status, strings.Join(reasons, ", "))
+}
+
+func getCloudFormationStackSetOperationFailures(conn *cloudformation.CloudFormation, stackSetName, operationId string) ([]string, error) {
+	var reasons []string
+
+	input := &cloudformation.ListStackSetOperationResultsInput{
+		OperationId:  aws.String(operationId),
+		StackSetName: aws.String(stackSetName),
+	}
+	for {
+		resp, err := conn.ListStackSetOperationResults(input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, s := range resp.Summaries {
+			if aws.StringValue(s.Status) == cloudformation.StackSetOperationResultStatusSucceeded {
+				continue
+			}
+			reasons = append(reasons, fmt.Sprintf("%s/%s: %s (%s)",
+				aws.StringValue(s.Account), aws.StringValue(s.Region),
+				aws.StringValue(s.Status), aws.StringValue(s.StatusReason)))
+		}
+
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+
+	return reasons, nil
+}
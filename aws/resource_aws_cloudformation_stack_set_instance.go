@@ -0,0 +1,246 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsCloudFormationStackSetInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudFormationStackSetInstanceCreate,
+		Read:   resourceAwsCloudFormationStackSetInstanceRead,
+		Update: resourceAwsCloudFormationStackSetInstanceUpdate,
+		Delete: resourceAwsCloudFormationStackSetInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"stack_set_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"parameter_overrides": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"retain_stack": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"stack_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCloudFormationStackSetInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	stackSetName := d.Get("stack_set_name").(string)
+
+	accountId := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("account_id"); ok {
+		accountId = v.(string)
+	}
+
+	region := meta.(*AWSClient).region
+	if v, ok := d.GetOk("region"); ok {
+		region = v.(string)
+	}
+
+	input := &cloudformation.CreateStackInstancesInput{
+		Accounts:     []*string{aws.String(accountId)},
+		Regions:      []*string{aws.String(region)},
+		StackSetName: aws.String(stackSetName),
+	}
+	if v, ok := d.GetOk("parameter_overrides"); ok {
+		input.ParameterOverrides = expandCloudFormationParameters(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating CloudFormation Stack Set Instance: %s", input)
+	var resp *cloudformation.CreateStackInstancesOutput
+	// Only one operation may run against a stack set at a time.
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		var err error
+		resp, err = conn.CreateStackInstances(input)
+		if isAWSErr(err, cloudformation.ErrCodeOperationInProgressException, "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating CloudFormation Stack Set (%s) Instance: %s", stackSetName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s,%s", stackSetName, accountId, region))
+
+	if err := waitForCloudFormationStackSetOperation(conn, stackSetName, aws.StringValue(resp.OperationId), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("Error waiting for CloudFormation Stack Set Instance (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsCloudFormationStackSetInstanceRead(d, meta)
+}
+
+func resourceAwsCloudFormationStackSetInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	stackSetName, accountId, region, err := decodeCloudFormationStackSetInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.DescribeStackInstance(&cloudformation.DescribeStackInstanceInput{
+		StackInstanceAccount: aws.String(accountId),
+		StackInstanceRegion:  aws.String(region),
+		StackSetName:         aws.String(stackSetName),
+	})
+	if err != nil {
+		if isAWSErr(err, cloudformation.ErrCodeStackInstanceNotFoundException, "") || isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+			log.Printf("[WARN] CloudFormation Stack Set Instance (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading CloudFormation Stack Set Instance (%s): %s", d.Id(), err)
+	}
+
+	instance := resp.StackInstance
+	d.Set("stack_set_name", stackSetName)
+	d.Set("account_id", instance.Account)
+	d.Set("region", instance.Region)
+	d.Set("stack_id", instance.StackId)
+	if err := d.Set("parameter_overrides", flattenAllCloudFormationParameters(instance.ParameterOverrides)); err != nil {
+		return fmt.Errorf("Error setting parameter_overrides: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCloudFormationStackSetInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	if d.HasChange("parameter_overrides") {
+		stackSetName, accountId, region, err := decodeCloudFormationStackSetInstanceID(d.Id())
+		if err != nil {
+			return err
+		}
+
+		input := &cloudformation.UpdateStackInstancesInput{
+			Accounts:     []*string{aws.String(accountId)},
+			Regions:      []*string{aws.String(region)},
+			StackSetName: aws.String(stackSetName),
+			// An empty list removes all existing overrides.
+			ParameterOverrides: []*cloudformation.Parameter{},
+		}
+		if v, ok := d.GetOk("parameter_overrides"); ok {
+			input.ParameterOverrides = expandCloudFormationParameters(v.(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating CloudFormation Stack Set Instance: %s", input)
+		var resp *cloudformation.UpdateStackInstancesOutput
+		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			var err error
+			resp, err = conn.UpdateStackInstances(input)
+			if isAWSErr(err, cloudformation.ErrCodeOperationInProgressException, "") {
+				return resource.RetryableError(err)
+			}
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating CloudFormation Stack Set Instance (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForCloudFormationStackSetOperation(conn, stackSetName, aws.StringValue(resp.OperationId), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("Error waiting for CloudFormation Stack Set Instance (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsCloudFormationStackSetInstanceRead(d, meta)
+}
+
+func resourceAwsCloudFormationStackSetInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	stackSetName, accountId, region, err := decodeCloudFormationStackSetInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &cloudformation.DeleteStackInstancesInput{
+		Accounts:     []*string{aws.String(accountId)},
+		Regions:      []*string{aws.String(region)},
+		RetainStacks: aws.Bool(d.Get("retain_stack").(bool)),
+		StackSetName: aws.String(stackSetName),
+	}
+
+	log.Printf("[DEBUG] Deleting CloudFormation Stack Set Instance: %s", input)
+	var resp *cloudformation.DeleteStackInstancesOutput
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		var err error
+		resp, err = conn.DeleteStackInstances(input)
+		if isAWSErr(err, cloudformation.ErrCodeOperationInProgressException, "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		if isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting CloudFormation Stack Set Instance (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForCloudFormationStackSetOperation(conn, stackSetName, aws.StringValue(resp.OperationId), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("Error waiting for CloudFormation Stack Set Instance (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeCloudFormationStackSetInstanceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, ",")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("Unexpected format of ID (%s), expected STACK_SET_NAME,ACCOUNT_ID,REGION", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
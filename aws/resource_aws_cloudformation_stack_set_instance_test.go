@@ -0,0 +1,164 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCloudFormationStackSetInstance_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudformation_stack_set_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationStackSetInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackSetInstanceConfig(rName, "10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudFormationStackSetInstanceExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "stack_set_name", "aws_cloudformation_stack_set.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "parameter_overrides.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameter_overrides.VPCCidr", "10.0.0.0/16"),
+					resource.TestCheckResourceAttrSet(resourceName, "region"),
+					resource.TestCheckResourceAttrSet(resourceName, "stack_id"),
+				),
+			},
+			{
+				Config: testAccAWSCloudFormationStackSetInstanceConfig(rName, "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudFormationStackSetInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameter_overrides.VPCCidr", "10.1.0.0/16"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retain_stack"},
+			},
+		},
+	})
+}
+
+func TestDecodeCloudFormationStackSetInstanceID(t *testing.T) {
+	cases := []struct {
+		ID        string
+		Name      string
+		AccountId string
+		Region    string
+		ErrCount  int
+	}{
+		{
+			ID:        "example,123456789012,us-east-1",
+			Name:      "example",
+			AccountId: "123456789012",
+			Region:    "us-east-1",
+		},
+		{
+			ID:       "example,123456789012",
+			ErrCount: 1,
+		},
+		{
+			ID:       "example,,us-east-1",
+			ErrCount: 1,
+		},
+		{
+			ID:       "example",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		name, accountId, region, err := decodeCloudFormationStackSetInstanceID(tc.ID)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.ID, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.ID)
+		}
+		if name != tc.Name || accountId != tc.AccountId || region != tc.Region {
+			t.Fatalf("expected %q to decode to (%q, %q, %q), received: (%q, %q, %q)",
+				tc.ID, tc.Name, tc.AccountId, tc.Region, name, accountId, region)
+		}
+	}
+}
+
+func testAccCheckAWSCloudFormationStackSetInstanceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudformation_stack_set_instance" {
+			continue
+		}
+
+		stackSetName, accountId, region, err := decodeCloudFormationStackSetInstanceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.DescribeStackInstance(&cloudformation.DescribeStackInstanceInput{
+			StackInstanceAccount: aws.String(accountId),
+			StackInstanceRegion:  aws.String(region),
+			StackSetName:         aws.String(stackSetName),
+		})
+		if err != nil {
+			if isAWSErr(err, cloudformation.ErrCodeStackInstanceNotFoundException, "") || isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("CloudFormation Stack Set Instance %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSCloudFormationStackSetInstanceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudFormation Stack Set Instance ID is set")
+		}
+
+		stackSetName, accountId, region, err := decodeCloudFormationStackSetInstanceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+		_, err = conn.DescribeStackInstance(&cloudformation.DescribeStackInstanceInput{
+			StackInstanceAccount: aws.String(accountId),
+			StackInstanceRegion:  aws.String(region),
+			StackSetName:         aws.String(stackSetName),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSCloudFormationStackSetInstanceConfig(rName, cidr string) string {
+	return testAccAWSCloudFormationStackSetConfig(rName, "10.0.0.0/16", "test") + fmt.Sprintf(`
+resource "aws_cloudformation_stack_set_instance" "test" {
+  stack_set_name = "${aws_cloudformation_stack_set.test.name}"
+
+  parameter_overrides {
+    VPCCidr = "%s"
+  }
+}
+`, cidr)
+}
@@ -0,0 +1,203 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCloudFormationStackSet_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudformation_stack_set.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationStackSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackSetConfig(rName, "10.0.0.0/16", "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudFormationStackSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.VPCCidr", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "stack_set_id"),
+				),
+			},
+			{
+				Config: testAccAWSCloudFormationStackSetConfig(rName, "10.1.0.0/16", "test update"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudFormationStackSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "test update"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.VPCCidr", "10.1.0.0/16"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCloudFormationStackSetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudformation_stack_set" {
+			continue
+		}
+
+		resp, err := conn.DescribeStackSet(&cloudformation.DescribeStackSetInput{
+			StackSetName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		if aws.StringValue(resp.StackSet.Status) != cloudformation.StackSetStatusDeleted {
+			return fmt.Errorf("CloudFormation Stack Set %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSCloudFormationStackSetExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudFormation Stack Set ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+		_, err := conn.DescribeStackSet(&cloudformation.DescribeStackSetInput{
+			StackSetName: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSCloudFormationStackSetConfig_base() string {
+	return `
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "Administration" {
+  name = "AWSCloudFormationStackSetAdministrationRole"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": "cloudformation.amazonaws.com"},
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy" "Administration" {
+  name = "AssumeRole-AWSCloudFormationStackSetExecutionRole"
+  role = "${aws_iam_role.Administration.name}"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "sts:AssumeRole",
+      "Resource": "arn:${data.aws_partition.current.partition}:iam::*:role/AWSCloudFormationStackSetExecutionRole"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role" "Execution" {
+  name = "AWSCloudFormationStackSetExecutionRole"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"AWS": "${aws_iam_role.Administration.arn}"},
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy_attachment" "Execution" {
+  role       = "${aws_iam_role.Execution.name}"
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AdministratorAccess"
+}
+`
+}
+
+func testAccAWSCloudFormationStackSetConfig(rName, cidr, description string) string {
+	return testAccAWSCloudFormationStackSetConfig_base() + fmt.Sprintf(`
+resource "aws_cloudformation_stack_set" "test" {
+  name        = "%s"
+  description = "%s"
+
+  parameters {
+    VPCCidr = "%s"
+  }
+
+  tags {
+    Name = "%s"
+  }
+
+  template_body = <<TEMPLATE
+{
+  "Parameters" : {
+    "VPCCidr" : {
+      "Type" : "String",
+      "Default" : "10.0.0.0/16"
+    }
+  },
+  "Resources" : {
+    "myVpc": {
+      "Type" : "AWS::EC2::VPC",
+      "Properties" : {
+        "CidrBlock" : { "Ref" : "VPCCidr" }
+      }
+    }
+  }
+}
+TEMPLATE
+
+  depends_on = [
+    "aws_iam_role_policy.Administration",
+    "aws_iam_role_policy_attachment.Execution",
+  ]
+}
+`, rName, description, cidr, rName)
+}
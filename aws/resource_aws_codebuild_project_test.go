@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 	"unicode"
@@ -69,6 +70,94 @@ func TestAccAWSCodeBuildProject_default_build_timeout(t *testing.T) {
 	})
 }
 
+func TestAccAWSCodeBuildProject_badgeEnabled(t *testing.T) {
+	name := acctest.RandString(10)
+	resourceName := "aws_codebuild_project.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodeBuildProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCodeBuildProjectConfig_badgeEnabled(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeBuildProjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "badge_enabled", "true"),
+					resource.TestMatchResourceAttr(resourceName, "badge_url", regexp.MustCompile(`\b(https?).*\b`)),
+				),
+			},
+			{
+				Config: testAccAWSCodeBuildProjectConfig_badgeEnabled(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeBuildProjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "badge_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "badge_url", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCodeBuildProject_cache(t *testing.T) {
+	name := acctest.RandString(10)
+	resourceName := "aws_codebuild_project.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodeBuildProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCodeBuildProjectConfig_cache(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeBuildProjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cache.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cache.0.type", "S3"),
+					resource.TestCheckResourceAttrSet(resourceName, "cache.0.location"),
+				),
+			},
+			{
+				Config: testAccAWSCodeBuildProjectConfig_badgeEnabled(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeBuildProjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cache.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCodeBuildProject_vpcConfig(t *testing.T) {
+	name := acctest.RandString(10)
+	resourceName := "aws_codebuild_project.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodeBuildProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCodeBuildProjectConfig_vpcConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeBuildProjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_config.0.vpc_id", "aws_vpc.codebuild_vpc", "id"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.subnets.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.security_group_ids.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSCodeBuildProjectConfig_badgeEnabled(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeBuildProjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAWSCodeBuildProject_artifactsTypeValidation(t *testing.T) {
 	cases := []struct {
 		Value    string
@@ -570,3 +659,170 @@ resource "aws_codebuild_project" "foo" {
 }
 `, rName, rName, rName, rName)
 }
+
+func testAccAWSCodeBuildProjectConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "codebuild_role" {
+  name = "codebuild-role-%s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "codebuild.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "codebuild_policy" {
+  name = "codebuild-policy-%s"
+  role = "${aws_iam_role.codebuild_role.name}"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Resource": [
+        "*"
+      ],
+      "Action": [
+        "logs:CreateLogGroup",
+        "logs:CreateLogStream",
+        "logs:PutLogEvents",
+        "s3:GetObject",
+        "s3:PutObject",
+        "ec2:CreateNetworkInterface",
+        "ec2:DescribeDhcpOptions",
+        "ec2:DescribeNetworkInterfaces",
+        "ec2:DeleteNetworkInterface",
+        "ec2:DescribeSubnets",
+        "ec2:DescribeSecurityGroups",
+        "ec2:DescribeVpcs",
+        "ec2:CreateNetworkInterfacePermission"
+      ]
+    }
+  ]
+}
+POLICY
+}
+`, rName, rName)
+}
+
+func testAccAWSCodeBuildProjectConfig_badgeEnabled(rName string, badgeEnabled bool) string {
+	return testAccAWSCodeBuildProjectConfig_base(rName) + fmt.Sprintf(`
+resource "aws_codebuild_project" "foo" {
+  name          = "test-project-%s"
+  badge_enabled = %t
+  service_role  = "${aws_iam_role.codebuild_role.arn}"
+
+  artifacts {
+    type = "NO_ARTIFACTS"
+  }
+
+  environment {
+    compute_type = "BUILD_GENERAL1_SMALL"
+    image        = "2"
+    type         = "LINUX_CONTAINER"
+  }
+
+  source {
+    type     = "GITHUB"
+    location = "https://github.com/hashicorp/packer.git"
+  }
+}
+`, rName, badgeEnabled)
+}
+
+func testAccAWSCodeBuildProjectConfig_cache(rName string) string {
+	return testAccAWSCodeBuildProjectConfig_base(rName) + fmt.Sprintf(`
+resource "aws_s3_bucket" "cache" {
+  bucket        = "tf-test-codebuild-cache-%s"
+  force_destroy = true
+}
+
+resource "aws_codebuild_project" "foo" {
+  name         = "test-project-%s"
+  service_role = "${aws_iam_role.codebuild_role.arn}"
+
+  artifacts {
+    type = "NO_ARTIFACTS"
+  }
+
+  cache {
+    type     = "S3"
+    location = "${aws_s3_bucket.cache.bucket}/cache"
+  }
+
+  environment {
+    compute_type = "BUILD_GENERAL1_SMALL"
+    image        = "2"
+    type         = "LINUX_CONTAINER"
+  }
+
+  source {
+    type     = "GITHUB"
+    location = "https://github.com/hashicorp/packer.git"
+  }
+}
+`, strings.ToLower(rName), rName)
+}
+
+func testAccAWSCodeBuildProjectConfig_vpcConfig(rName string) string {
+	return testAccAWSCodeBuildProjectConfig_base(rName) + fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+resource "aws_vpc" "codebuild_vpc" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-codebuild-project-vpc-config"
+  }
+}
+
+resource "aws_subnet" "codebuild_subnet" {
+  count             = 2
+  vpc_id            = "${aws_vpc.codebuild_vpc.id}"
+  cidr_block        = "10.0.${count.index}.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[count.index]}"
+}
+
+resource "aws_security_group" "codebuild_security_group" {
+  vpc_id = "${aws_vpc.codebuild_vpc.id}"
+}
+
+resource "aws_codebuild_project" "foo" {
+  name         = "test-project-%s"
+  service_role = "${aws_iam_role.codebuild_role.arn}"
+
+  artifacts {
+    type = "NO_ARTIFACTS"
+  }
+
+  environment {
+    compute_type = "BUILD_GENERAL1_SMALL"
+    image        = "2"
+    type         = "LINUX_CONTAINER"
+  }
+
+  source {
+    type     = "GITHUB"
+    location = "https://github.com/hashicorp/packer.git"
+  }
+
+  vpc_config {
+    vpc_id             = "${aws_vpc.codebuild_vpc.id}"
+    subnets            = ["${aws_subnet.codebuild_subnet.*.id}"]
+    security_group_ids = ["${aws_security_group.codebuild_security_group.id}"]
+  }
+}
+`, rName)
+}
@@ -0,0 +1,94 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsCodeBuildWebhook() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCodeBuildWebhookCreate,
+		Read:   resourceAwsCodeBuildWebhookRead,
+		Delete: resourceAwsCodeBuildWebhookDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCodeBuildWebhookCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codebuildconn
+
+	projectName := d.Get("project_name").(string)
+
+	log.Printf("[DEBUG] Creating CodeBuild webhook for project: %s", projectName)
+	_, err := conn.CreateWebhook(&codebuild.CreateWebhookInput{
+		ProjectName: aws.String(projectName),
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating CodeBuild webhook for project %q: %s", projectName, err)
+	}
+
+	d.SetId(projectName)
+
+	return resourceAwsCodeBuildWebhookRead(d, meta)
+}
+
+func resourceAwsCodeBuildWebhookRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codebuildconn
+
+	resp, err := conn.BatchGetProjects(&codebuild.BatchGetProjectsInput{
+		Names: []*string{
+			aws.String(d.Id()),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading CodeBuild project %q: %s", d.Id(), err)
+	}
+
+	if len(resp.Projects) == 0 || resp.Projects[0].Webhook == nil {
+		log.Printf("[WARN] CodeBuild webhook (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	project := resp.Projects[0]
+
+	d.Set("project_name", project.Name)
+	d.Set("url", project.Webhook.Url)
+
+	return nil
+}
+
+func resourceAwsCodeBuildWebhookDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codebuildconn
+
+	log.Printf("[DEBUG] Deleting CodeBuild webhook for project: %s", d.Id())
+	_, err := conn.DeleteWebhook(&codebuild.DeleteWebhookInput{
+		ProjectName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, codebuild.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting CodeBuild webhook for project %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCodeBuildWebhook_basic(t *testing.T) {
+	if os.Getenv("GITHUB_TOKEN") == "" {
+		t.Skip("Environment variable GITHUB_TOKEN is not set")
+	}
+
+	name := acctest.RandString(10)
+	resourceName := "aws_codebuild_webhook.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodeBuildWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCodeBuildWebhookConfig_basic(name, os.Getenv("GITHUB_TOKEN")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeBuildWebhookExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "project_name", fmt.Sprintf("test-project-%s", name)),
+					resource.TestCheckResourceAttrSet(resourceName, "url"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCodeBuildWebhookDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).codebuildconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_codebuild_webhook" {
+			continue
+		}
+
+		resp, err := conn.BatchGetProjects(&codebuild.BatchGetProjectsInput{
+			Names: []*string{
+				aws.String(rs.Primary.ID),
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.Projects) > 0 && resp.Projects[0].Webhook != nil {
+			return fmt.Errorf("CodeBuild webhook for project %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSCodeBuildWebhookExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CodeBuild webhook ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).codebuildconn
+
+		resp, err := conn.BatchGetProjects(&codebuild.BatchGetProjectsInput{
+			Names: []*string{
+				aws.String(rs.Primary.ID),
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.Projects) == 0 || resp.Projects[0].Webhook == nil {
+			return fmt.Errorf("CodeBuild webhook for project %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSCodeBuildWebhookConfig_basic(rName, githubToken string) string {
+	return testAccAWSCodeBuildProjectConfig_base(rName) + fmt.Sprintf(`
+resource "aws_codebuild_project" "test" {
+  name         = "test-project-%s"
+  service_role = "${aws_iam_role.codebuild_role.arn}"
+
+  artifacts {
+    type = "NO_ARTIFACTS"
+  }
+
+  environment {
+    compute_type = "BUILD_GENERAL1_SMALL"
+    image        = "2"
+    type         = "LINUX_CONTAINER"
+  }
+
+  source {
+    type     = "GITHUB"
+    location = "https://github.com/hashicorp/packer.git"
+
+    auth {
+      type     = "OAUTH"
+      resource = "%s"
+    }
+  }
+}
+
+resource "aws_codebuild_webhook" "test" {
+  project_name = "${aws_codebuild_project.test.name}"
+}
+`, rName, githubToken)
+}
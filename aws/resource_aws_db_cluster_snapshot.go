@@ -0,0 +1,221 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDbClusterSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDbClusterSnapshotCreate,
+		Read:   resourceAwsDbClusterSnapshotRead,
+		Delete: resourceAwsDbClusterSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"db_cluster_snapshot_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"db_cluster_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"allocated_storage": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"availability_zones": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"db_cluster_snapshot_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"storage_encrypted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"license_model": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"source_db_cluster_snapshot_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"snapshot_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDbClusterSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	params := &rds.CreateDBClusterSnapshotInput{
+		DBClusterIdentifier:         aws.String(d.Get("db_cluster_identifier").(string)),
+		DBClusterSnapshotIdentifier: aws.String(d.Get("db_cluster_snapshot_identifier").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating DB Cluster Snapshot: %s", params)
+	_, err := conn.CreateDBClusterSnapshot(params)
+	if err != nil {
+		return fmt.Errorf("Error creating DB Cluster Snapshot: %s", err)
+	}
+	d.SetId(d.Get("db_cluster_snapshot_identifier").(string))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating"},
+		Target:     []string{"available"},
+		Refresh:    resourceAwsDbClusterSnapshotStateRefreshFunc(d.Id(), conn),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 10 * time.Second,
+		Delay:      5 * time.Second,
+	}
+
+	// Wait, catching any errors
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for DB Cluster Snapshot %q to become available: %s", d.Id(), err)
+	}
+
+	return resourceAwsDbClusterSnapshotRead(d, meta)
+}
+
+func resourceAwsDbClusterSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	params := &rds.DescribeDBClusterSnapshotsInput{
+		DBClusterSnapshotIdentifier: aws.String(d.Id()),
+	}
+	resp, err := conn.DescribeDBClusterSnapshots(params)
+	if err != nil {
+		if isAWSErr(err, rds.ErrCodeDBClusterSnapshotNotFoundFault, "") {
+			log.Printf("[WARN] DB Cluster Snapshot %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading DB Cluster Snapshot %q: %s", d.Id(), err)
+	}
+
+	if resp == nil || len(resp.DBClusterSnapshots) == 0 || resp.DBClusterSnapshots[0] == nil {
+		log.Printf("[WARN] DB Cluster Snapshot %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return dbClusterSnapshotDescriptionAttributes(d, resp.DBClusterSnapshots[0])
+}
+
+func resourceAwsDbClusterSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	params := &rds.DeleteDBClusterSnapshotInput{
+		DBClusterSnapshotIdentifier: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting DB Cluster Snapshot: %s", params)
+	_, err := conn.DeleteDBClusterSnapshot(params)
+	if err != nil {
+		if isAWSErr(err, rds.ErrCodeDBClusterSnapshotNotFoundFault, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting DB Cluster Snapshot %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsDbClusterSnapshotStateRefreshFunc(
+	id string, conn *rds.RDS) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		opts := &rds.DescribeDBClusterSnapshotsInput{
+			DBClusterSnapshotIdentifier: aws.String(id),
+		}
+
+		log.Printf("[DEBUG] DB Cluster Snapshot describe configuration: %#v", opts)
+
+		resp, err := conn.DescribeDBClusterSnapshots(opts)
+		if err != nil {
+			if isAWSErr(err, rds.ErrCodeDBClusterSnapshotNotFoundFault, "") {
+				return nil, "", nil
+			}
+			return nil, "", fmt.Errorf("Error retrieving DB Cluster Snapshots: %s", err)
+		}
+
+		if resp == nil || len(resp.DBClusterSnapshots) == 0 || resp.DBClusterSnapshots[0] == nil {
+			return nil, "", fmt.Errorf("No snapshots returned for %s", id)
+		}
+
+		snapshot := resp.DBClusterSnapshots[0]
+
+		return resp, aws.StringValue(snapshot.Status), nil
+	}
+}
+
+func dbClusterSnapshotDescriptionAttributes(d *schema.ResourceData, snapshot *rds.DBClusterSnapshot) error {
+	d.SetId(aws.StringValue(snapshot.DBClusterSnapshotIdentifier))
+	d.Set("db_cluster_identifier", snapshot.DBClusterIdentifier)
+	d.Set("db_cluster_snapshot_identifier", snapshot.DBClusterSnapshotIdentifier)
+	d.Set("allocated_storage", snapshot.AllocatedStorage)
+	if err := d.Set("availability_zones", flattenStringList(snapshot.AvailabilityZones)); err != nil {
+		return fmt.Errorf("Error setting availability_zones: %s", err)
+	}
+	d.Set("db_cluster_snapshot_arn", snapshot.DBClusterSnapshotArn)
+	d.Set("storage_encrypted", snapshot.StorageEncrypted)
+	d.Set("engine", snapshot.Engine)
+	d.Set("engine_version", snapshot.EngineVersion)
+	d.Set("kms_key_id", snapshot.KmsKeyId)
+	d.Set("license_model", snapshot.LicenseModel)
+	d.Set("port", snapshot.Port)
+	d.Set("source_db_cluster_snapshot_arn", snapshot.SourceDBClusterSnapshotArn)
+	d.Set("snapshot_type", snapshot.SnapshotType)
+	d.Set("status", snapshot.Status)
+	d.Set("vpc_id", snapshot.VpcId)
+
+	return nil
+}
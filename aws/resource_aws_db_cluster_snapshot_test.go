@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDBClusterSnapshot_basic(t *testing.T) {
+	var v rds.DBClusterSnapshot
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDbClusterSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDbClusterSnapshotConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbClusterSnapshotExists("aws_db_cluster_snapshot.test", &v),
+					resource.TestCheckResourceAttr("aws_db_cluster_snapshot.test", "status", "available"),
+					resource.TestCheckResourceAttr("aws_db_cluster_snapshot.test", "snapshot_type", "manual"),
+					resource.TestCheckResourceAttrSet("aws_db_cluster_snapshot.test", "db_cluster_snapshot_arn"),
+				),
+			},
+			{
+				ResourceName:      "aws_db_cluster_snapshot.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDbClusterSnapshotExists(n string, v *rds.DBClusterSnapshot) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+
+		request := &rds.DescribeDBClusterSnapshotsInput{
+			DBClusterSnapshotIdentifier: aws.String(rs.Primary.ID),
+		}
+
+		response, err := conn.DescribeDBClusterSnapshots(request)
+		if err == nil {
+			if response.DBClusterSnapshots != nil && len(response.DBClusterSnapshots) > 0 {
+				*v = *response.DBClusterSnapshots[0]
+				return nil
+			}
+		}
+		return fmt.Errorf("Error finding RDS DB Cluster Snapshot %s", rs.Primary.ID)
+	}
+}
+
+func testAccCheckDbClusterSnapshotDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).rdsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_db_cluster_snapshot" {
+			continue
+		}
+
+		response, err := conn.DescribeDBClusterSnapshots(&rds.DescribeDBClusterSnapshotsInput{
+			DBClusterSnapshotIdentifier: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, rds.ErrCodeDBClusterSnapshotNotFoundFault, "") {
+				continue
+			}
+			return err
+		}
+
+		if len(response.DBClusterSnapshots) > 0 {
+			return fmt.Errorf("RDS DB Cluster Snapshot %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsDbClusterSnapshotConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_rds_cluster" "test" {
+  cluster_identifier  = "tf-aurora-cluster-%d"
+  availability_zones  = ["us-west-2a","us-west-2b","us-west-2c"]
+  database_name       = "mydb"
+  master_username     = "foo"
+  master_password     = "mustbeeightcharaters"
+  skip_final_snapshot = true
+}
+
+resource "aws_db_cluster_snapshot" "test" {
+  db_cluster_identifier          = "${aws_rds_cluster.test.id}"
+  db_cluster_snapshot_identifier = "tf-aurora-snapshot-%d"
+}
+`, rInt, rInt)
+}
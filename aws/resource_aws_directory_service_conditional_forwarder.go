@@ -0,0 +1,155 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDirectoryServiceConditionalForwarder() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDirectoryServiceConditionalForwarderCreate,
+		Read:   resourceAwsDirectoryServiceConditionalForwarderRead,
+		Update: resourceAwsDirectoryServiceConditionalForwarderUpdate,
+		Delete: resourceAwsDirectoryServiceConditionalForwarderDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"remote_domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"dns_ips": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAwsDirectoryServiceConditionalForwarderCreate(d *schema.ResourceData, meta interface{}) error {
+	dsconn := meta.(*AWSClient).dsconn
+
+	directoryId := d.Get("directory_id").(string)
+	domainName := d.Get("remote_domain_name").(string)
+
+	input := &directoryservice.CreateConditionalForwarderInput{
+		DirectoryId:      aws.String(directoryId),
+		DnsIpAddrs:       expandStringList(d.Get("dns_ips").([]interface{})),
+		RemoteDomainName: aws.String(domainName),
+	}
+
+	log.Printf("[DEBUG] Creating DS conditional forwarder: %s", input)
+	_, err := dsconn.CreateConditionalForwarder(input)
+	if err != nil {
+		return fmt.Errorf("Error creating DS conditional forwarder: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", directoryId, domainName))
+
+	return resourceAwsDirectoryServiceConditionalForwarderRead(d, meta)
+}
+
+func resourceAwsDirectoryServiceConditionalForwarderRead(d *schema.ResourceData, meta interface{}) error {
+	dsconn := meta.(*AWSClient).dsconn
+
+	directoryId, domainName, err := parseDSConditionalForwarderId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := dsconn.DescribeConditionalForwarders(&directoryservice.DescribeConditionalForwardersInput{
+		DirectoryId:       aws.String(directoryId),
+		RemoteDomainNames: []*string{aws.String(domainName)},
+	})
+	if err != nil {
+		if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+			log.Printf("[WARN] DS conditional forwarder %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading DS conditional forwarder %q: %s", d.Id(), err)
+	}
+
+	if len(resp.ConditionalForwarders) == 0 {
+		log.Printf("[WARN] DS conditional forwarder %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	cfd := resp.ConditionalForwarders[0]
+
+	d.Set("directory_id", directoryId)
+	d.Set("remote_domain_name", cfd.RemoteDomainName)
+	d.Set("dns_ips", flattenStringList(cfd.DnsIpAddrs))
+
+	return nil
+}
+
+func resourceAwsDirectoryServiceConditionalForwarderUpdate(d *schema.ResourceData, meta interface{}) error {
+	dsconn := meta.(*AWSClient).dsconn
+
+	directoryId, domainName, err := parseDSConditionalForwarderId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &directoryservice.UpdateConditionalForwarderInput{
+		DirectoryId:      aws.String(directoryId),
+		DnsIpAddrs:       expandStringList(d.Get("dns_ips").([]interface{})),
+		RemoteDomainName: aws.String(domainName),
+	}
+
+	log.Printf("[DEBUG] Updating DS conditional forwarder: %s", input)
+	_, err = dsconn.UpdateConditionalForwarder(input)
+	if err != nil {
+		return fmt.Errorf("Error updating DS conditional forwarder %q: %s", d.Id(), err)
+	}
+
+	return resourceAwsDirectoryServiceConditionalForwarderRead(d, meta)
+}
+
+func resourceAwsDirectoryServiceConditionalForwarderDelete(d *schema.ResourceData, meta interface{}) error {
+	dsconn := meta.(*AWSClient).dsconn
+
+	directoryId, domainName, err := parseDSConditionalForwarderId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &directoryservice.DeleteConditionalForwarderInput{
+		DirectoryId:      aws.String(directoryId),
+		RemoteDomainName: aws.String(domainName),
+	}
+
+	log.Printf("[DEBUG] Deleting DS conditional forwarder: %s", input)
+	_, err = dsconn.DeleteConditionalForwarder(input)
+	if err != nil && !isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+		return fmt.Errorf("Error deleting DS conditional forwarder %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func parseDSConditionalForwarderId(id string) (directoryId, domainName string, err error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("DS conditional forwarder ID must be of the form <directory_id>:<remote_domain_name>, was provided: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,166 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestParseDSConditionalForwarderId(t *testing.T) {
+	cases := []struct {
+		Id          string
+		DirectoryId string
+		DomainName  string
+		ErrCount    int
+	}{
+		{
+			Id:       "d-1234567890",
+			ErrCount: 1,
+		},
+		{
+			Id:       "d-1234567890:",
+			ErrCount: 1,
+		},
+		{
+			Id:          "d-1234567890:test.example.com",
+			DirectoryId: "d-1234567890",
+			DomainName:  "test.example.com",
+		},
+	}
+
+	for _, tc := range cases {
+		directoryId, domainName, err := parseDSConditionalForwarderId(tc.Id)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.Id, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.Id)
+		}
+		if directoryId != tc.DirectoryId || domainName != tc.DomainName {
+			t.Fatalf("expected %q to be parsed as %q and %q, got %q and %q", tc.Id, tc.DirectoryId, tc.DomainName, directoryId, domainName)
+		}
+	}
+}
+
+func TestAccAWSDirectoryServiceConditionForwarder_basic(t *testing.T) {
+	resourceName := "aws_directory_service_conditional_forwarder.fwd"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDirectoryServiceConditionalForwarderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryServiceConditionalForwarderConfig("8.8.8.8", "8.8.4.4"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDirectoryServiceConditionalForwarderExists(resourceName, []string{"8.8.8.8", "8.8.4.4"}),
+				),
+			},
+			{
+				Config: testAccDirectoryServiceConditionalForwarderConfig("8.8.8.8", "1.1.1.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDirectoryServiceConditionalForwarderExists(resourceName, []string{"8.8.8.8", "1.1.1.1"}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDirectoryServiceConditionalForwarderDestroy(s *terraform.State) error {
+	dsconn := testAccProvider.Meta().(*AWSClient).dsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_directory_service_conditional_forwarder" {
+			continue
+		}
+
+		directoryId, domainName, err := parseDSConditionalForwarderId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		res, err := dsconn.DescribeConditionalForwarders(&directoryservice.DescribeConditionalForwardersInput{
+			DirectoryId:       aws.String(directoryId),
+			RemoteDomainNames: []*string{aws.String(domainName)},
+		})
+		if err != nil {
+			if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+				continue
+			}
+			return err
+		}
+
+		if len(res.ConditionalForwarders) > 0 {
+			return fmt.Errorf("Expected AWS Directory Service Conditional Forwarder to be gone, but was still found")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsDirectoryServiceConditionalForwarderExists(name string, dnsIps []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		directoryId, domainName, err := parseDSConditionalForwarderId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		dsconn := testAccProvider.Meta().(*AWSClient).dsconn
+
+		res, err := dsconn.DescribeConditionalForwarders(&directoryservice.DescribeConditionalForwardersInput{
+			DirectoryId:       aws.String(directoryId),
+			RemoteDomainNames: []*string{aws.String(domainName)},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(res.ConditionalForwarders) == 0 {
+			return fmt.Errorf("No Conditional Forwarder found")
+		}
+
+		cfd := res.ConditionalForwarders[0]
+		if len(cfd.DnsIpAddrs) != len(dnsIps) {
+			return fmt.Errorf("DnsIpAddrs length mismatch, expected %d, got %d", len(dnsIps), len(cfd.DnsIpAddrs))
+		}
+		for i, ip := range dnsIps {
+			if aws.StringValue(cfd.DnsIpAddrs[i]) != ip {
+				return fmt.Errorf("DnsIp mismatch, expected %q, got %q", ip, aws.StringValue(cfd.DnsIpAddrs[i]))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccDirectoryServiceConditionalForwarderConfig(ip1, ip2 string) string {
+	return testAccDirectoryServiceDirectoryConfig_microsoft + fmt.Sprintf(`
+resource "aws_directory_service_conditional_forwarder" "fwd" {
+  directory_id       = "${aws_directory_service_directory.bar.id}"
+  remote_domain_name = "test.example.com"
+
+  dns_ips = [
+    "%s",
+    "%s",
+  ]
+}
+`, ip1, ip2)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/directoryservice"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 var directoryCreationFuncs = map[string]func(*directoryservice.DirectoryService, *schema.ResourceData) (string, error){
@@ -124,6 +125,65 @@ func resourceAwsDirectoryServiceDirectory() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"radius_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"radius_servers": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"radius_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1025, 65535),
+						},
+						"radius_timeout": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 20),
+						},
+						"radius_retries": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 10),
+						},
+						"shared_secret": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringLenBetween(8, 512),
+						},
+						"authentication_protocol": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								directoryservice.RadiusAuthenticationProtocolPap,
+								directoryservice.RadiusAuthenticationProtocolChap,
+								directoryservice.RadiusAuthenticationProtocolMsChapv1,
+								directoryservice.RadiusAuthenticationProtocolMsChapv2,
+							}, false),
+						},
+						"display_label": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"use_same_username": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"radius_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"access_url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -399,6 +459,40 @@ func resourceAwsDirectoryServiceDirectoryUpdate(d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("radius_settings") {
+		d.SetPartial("radius_settings")
+		o, n := d.GetChange("radius_settings")
+		var err error
+
+		if len(n.([]interface{})) == 0 {
+			log.Printf("[DEBUG] Disabling RADIUS for DS directory %q", d.Id())
+			_, err = dsconn.DisableRadius(&directoryservice.DisableRadiusInput{
+				DirectoryId: aws.String(d.Id()),
+			})
+		} else if len(o.([]interface{})) == 0 {
+			log.Printf("[DEBUG] Enabling RADIUS for DS directory %q", d.Id())
+			_, err = dsconn.EnableRadius(&directoryservice.EnableRadiusInput{
+				DirectoryId:    aws.String(d.Id()),
+				RadiusSettings: expandDSRadiusSettings(n.([]interface{})),
+			})
+		} else {
+			log.Printf("[DEBUG] Updating RADIUS for DS directory %q", d.Id())
+			_, err = dsconn.UpdateRadius(&directoryservice.UpdateRadiusInput{
+				DirectoryId:    aws.String(d.Id()),
+				RadiusSettings: expandDSRadiusSettings(n.([]interface{})),
+			})
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(n.([]interface{})) > 0 {
+			if err := waitForDirectoryServiceRadius(dsconn, d.Id()); err != nil {
+				return err
+			}
+		}
+	}
+
 	if err := setTagsDS(dsconn, d, d.Id()); err != nil {
 		return err
 	}
@@ -449,6 +543,16 @@ func resourceAwsDirectoryServiceDirectoryRead(d *schema.ResourceData, meta inter
 	d.Set("vpc_settings", flattenDSVpcSettings(dir.VpcSettings))
 	d.Set("connect_settings", flattenDSConnectSettings(dir.DnsIpAddrs, dir.ConnectSettings))
 	d.Set("enable_sso", *dir.SsoEnabled)
+	d.Set("radius_status", dir.RadiusStatus)
+
+	// The shared secret is not returned by the API
+	var sharedSecret string
+	if v, ok := d.GetOk("radius_settings.0.shared_secret"); ok {
+		sharedSecret = v.(string)
+	}
+	if err := d.Set("radius_settings", flattenDSRadiusSettings(dir.RadiusSettings, sharedSecret)); err != nil {
+		return fmt.Errorf("error setting radius_settings: %s", err)
+	}
 
 	if dir.VpcSettings != nil {
 		d.Set("security_group_id", *dir.VpcSettings.SecurityGroupId)
@@ -465,6 +569,41 @@ func resourceAwsDirectoryServiceDirectoryRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
+func waitForDirectoryServiceRadius(dsconn *directoryservice.DirectoryService, directoryId string) error {
+	log.Printf("[DEBUG] Waiting for RADIUS of DS (%q) to be configured", directoryId)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{directoryservice.RadiusStatusCreating},
+		Target:  []string{directoryservice.RadiusStatusCompleted},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := dsconn.DescribeDirectories(&directoryservice.DescribeDirectoriesInput{
+				DirectoryIds: []*string{aws.String(directoryId)},
+			})
+			if err != nil {
+				return nil, "", err
+			}
+			if len(resp.DirectoryDescriptions) == 0 {
+				return nil, "", fmt.Errorf("Directory Service (%s) not found", directoryId)
+			}
+
+			ds := resp.DirectoryDescriptions[0]
+			status := aws.StringValue(ds.RadiusStatus)
+			if status == directoryservice.RadiusStatusFailed {
+				return ds, status, fmt.Errorf("RADIUS configuration failed")
+			}
+			return ds, status, nil
+		},
+		Timeout:    10 * time.Minute,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf(
+			"Error waiting for Directory Service (%s) RADIUS configuration: %s",
+			directoryId, err)
+	}
+
+	return nil
+}
+
 func resourceAwsDirectoryServiceDirectoryDelete(d *schema.ResourceData, meta interface{}) error {
 	dsconn := meta.(*AWSClient).dsconn
 
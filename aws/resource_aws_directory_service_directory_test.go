@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"os"
 	"reflect"
 	"testing"
 
@@ -191,6 +192,37 @@ func TestAccAWSDirectoryServiceDirectory_withAliasAndSso(t *testing.T) {
 	})
 }
 
+func TestAccAWSDirectoryServiceDirectory_radius(t *testing.T) {
+	radiusServer := os.Getenv("DS_RADIUS_SERVER")
+	if radiusServer == "" {
+		t.Skip("Environment variable DS_RADIUS_SERVER is not set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDirectoryServiceDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryServiceDirectoryConfig_radius(radiusServer),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceDirectoryExists("aws_directory_service_directory.bar"),
+					resource.TestCheckResourceAttr("aws_directory_service_directory.bar", "radius_settings.#", "1"),
+					resource.TestCheckResourceAttr("aws_directory_service_directory.bar", "radius_settings.0.radius_port", "1812"),
+					resource.TestCheckResourceAttr("aws_directory_service_directory.bar", "radius_status", "Completed"),
+				),
+			},
+			{
+				Config: testAccDirectoryServiceDirectoryConfig_microsoft,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceDirectoryExists("aws_directory_service_directory.bar"),
+					resource.TestCheckResourceAttr("aws_directory_service_directory.bar", "radius_settings.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDirectoryServiceDirectoryDestroy(s *terraform.State) error {
 	dsconn := testAccProvider.Meta().(*AWSClient).dsconn
 
@@ -455,6 +487,49 @@ resource "aws_subnet" "bar" {
 }
 `
 
+func testAccDirectoryServiceDirectoryConfig_radius(radiusServer string) string {
+	return fmt.Sprintf(`
+resource "aws_directory_service_directory" "bar" {
+  name = "corp.notexample.com"
+  password = "SuperSecretPassw0rd"
+  type = "MicrosoftAD"
+
+  vpc_settings {
+    vpc_id = "${aws_vpc.main.id}"
+    subnet_ids = ["${aws_subnet.foo.id}", "${aws_subnet.bar.id}"]
+  }
+
+  radius_settings {
+    radius_servers = ["%s"]
+    radius_port = 1812
+    radius_timeout = 5
+    radius_retries = 3
+    shared_secret = "SuperSecretSharedSecret"
+    authentication_protocol = "PAP"
+    display_label = "MFA"
+  }
+}
+
+resource "aws_vpc" "main" {
+  cidr_block = "10.0.0.0/16"
+	tags {
+		Name = "testAccDirectoryServiceDirectoryConfig_radius"
+	}
+}
+
+resource "aws_subnet" "foo" {
+  vpc_id = "${aws_vpc.main.id}"
+  availability_zone = "us-west-2a"
+  cidr_block = "10.0.1.0/24"
+}
+resource "aws_subnet" "bar" {
+  vpc_id = "${aws_vpc.main.id}"
+  availability_zone = "us-west-2b"
+  cidr_block = "10.0.2.0/24"
+}
+`, radiusServer)
+}
+
 var randomInteger = acctest.RandInt()
 var testAccDirectoryServiceDirectoryConfig_withAlias = fmt.Sprintf(`
 resource "aws_directory_service_directory" "bar_a" {
@@ -0,0 +1,133 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDirectoryServiceEventTopic() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDirectoryServiceEventTopicCreate,
+		Read:   resourceAwsDirectoryServiceEventTopicRead,
+		Delete: resourceAwsDirectoryServiceEventTopicDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"topic_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"topic_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDirectoryServiceEventTopicCreate(d *schema.ResourceData, meta interface{}) error {
+	dsconn := meta.(*AWSClient).dsconn
+
+	directoryId := d.Get("directory_id").(string)
+	topicName := d.Get("topic_name").(string)
+
+	input := &directoryservice.RegisterEventTopicInput{
+		DirectoryId: aws.String(directoryId),
+		TopicName:   aws.String(topicName),
+	}
+
+	log.Printf("[DEBUG] Registering DS event topic: %s", input)
+	_, err := dsconn.RegisterEventTopic(input)
+	if err != nil {
+		return fmt.Errorf("Error registering DS event topic: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", directoryId, topicName))
+
+	return resourceAwsDirectoryServiceEventTopicRead(d, meta)
+}
+
+func resourceAwsDirectoryServiceEventTopicRead(d *schema.ResourceData, meta interface{}) error {
+	dsconn := meta.(*AWSClient).dsconn
+
+	directoryId, topicName, err := parseDSEventTopicId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := dsconn.DescribeEventTopics(&directoryservice.DescribeEventTopicsInput{
+		DirectoryId: aws.String(directoryId),
+		TopicNames:  []*string{aws.String(topicName)},
+	})
+	if err != nil {
+		if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+			log.Printf("[WARN] DS event topic %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading DS event topic %q: %s", d.Id(), err)
+	}
+
+	if len(resp.EventTopics) == 0 || aws.StringValue(resp.EventTopics[0].Status) == directoryservice.TopicStatusDeleted {
+		log.Printf("[WARN] DS event topic %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	topic := resp.EventTopics[0]
+
+	d.Set("directory_id", topic.DirectoryId)
+	d.Set("topic_name", topic.TopicName)
+	d.Set("topic_arn", topic.TopicArn)
+	d.Set("status", topic.Status)
+
+	return nil
+}
+
+func resourceAwsDirectoryServiceEventTopicDelete(d *schema.ResourceData, meta interface{}) error {
+	dsconn := meta.(*AWSClient).dsconn
+
+	directoryId, topicName, err := parseDSEventTopicId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &directoryservice.DeregisterEventTopicInput{
+		DirectoryId: aws.String(directoryId),
+		TopicName:   aws.String(topicName),
+	}
+
+	log.Printf("[DEBUG] Deregistering DS event topic: %s", input)
+	_, err = dsconn.DeregisterEventTopic(input)
+	if err != nil && !isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+		return fmt.Errorf("Error deregistering DS event topic %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func parseDSEventTopicId(id string) (directoryId, topicName string, err error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("DS event topic ID must be of the form <directory_id>:<topic_name>, was provided: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDirectoryServiceEventTopic_basic(t *testing.T) {
+	resourceName := "aws_directory_service_event_topic.test"
+	topicName := fmt.Sprintf("tf-acc-test-ds-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDirectoryServiceEventTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryServiceEventTopicConfig(topicName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDirectoryServiceEventTopicExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "topic_name", topicName),
+					resource.TestCheckResourceAttr(resourceName, "status", "Registered"),
+					resource.TestCheckResourceAttrPair(resourceName, "topic_arn", "aws_sns_topic.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDirectoryServiceEventTopicDestroy(s *terraform.State) error {
+	dsconn := testAccProvider.Meta().(*AWSClient).dsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_directory_service_event_topic" {
+			continue
+		}
+
+		directoryId, topicName, err := parseDSEventTopicId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		res, err := dsconn.DescribeEventTopics(&directoryservice.DescribeEventTopicsInput{
+			DirectoryId: aws.String(directoryId),
+			TopicNames:  []*string{aws.String(topicName)},
+		})
+		if err != nil {
+			if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+				continue
+			}
+			return err
+		}
+
+		for _, topic := range res.EventTopics {
+			if aws.StringValue(topic.Status) != directoryservice.TopicStatusDeleted {
+				return fmt.Errorf("Expected DS event topic %q to be gone, but was still found", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsDirectoryServiceEventTopicExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		directoryId, topicName, err := parseDSEventTopicId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		dsconn := testAccProvider.Meta().(*AWSClient).dsconn
+		res, err := dsconn.DescribeEventTopics(&directoryservice.DescribeEventTopicsInput{
+			DirectoryId: aws.String(directoryId),
+			TopicNames:  []*string{aws.String(topicName)},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(res.EventTopics) == 0 {
+			return fmt.Errorf("No DS event topic found")
+		}
+
+		return nil
+	}
+}
+
+func testAccDirectoryServiceEventTopicConfig(topicName string) string {
+	return testAccDirectoryServiceDirectoryConfig + fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = "%s"
+}
+
+resource "aws_directory_service_event_topic" "test" {
+  directory_id = "${aws_directory_service_directory.bar.id}"
+  topic_name   = "${aws_sns_topic.test.name}"
+}
+`, topicName)
+}
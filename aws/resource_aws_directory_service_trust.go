@@ -0,0 +1,262 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsDirectoryServiceTrust() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDirectoryServiceTrustCreate,
+		Read:   resourceAwsDirectoryServiceTrustRead,
+		Update: schema.Noop,
+		Delete: resourceAwsDirectoryServiceTrustDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"remote_domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"trust_direction": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					directoryservice.TrustDirectionOneWayOutgoing,
+					directoryservice.TrustDirectionOneWayIncoming,
+					directoryservice.TrustDirectionTwoWay,
+				}, false),
+			},
+			"trust_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  directoryservice.TrustTypeForest,
+				ValidateFunc: validation.StringInSlice([]string{
+					directoryservice.TrustTypeForest,
+				}, false),
+			},
+			"trust_password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"conditional_forwarder_ip_addrs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"delete_associated_conditional_forwarder": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"verify": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"trust_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"trust_state_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDirectoryServiceTrustCreate(d *schema.ResourceData, meta interface{}) error {
+	dsconn := meta.(*AWSClient).dsconn
+
+	input := &directoryservice.CreateTrustInput{
+		DirectoryId:      aws.String(d.Get("directory_id").(string)),
+		RemoteDomainName: aws.String(d.Get("remote_domain_name").(string)),
+		TrustDirection:   aws.String(d.Get("trust_direction").(string)),
+		TrustPassword:    aws.String(d.Get("trust_password").(string)),
+		TrustType:        aws.String(d.Get("trust_type").(string)),
+	}
+	if v, ok := d.GetOk("conditional_forwarder_ip_addrs"); ok {
+		input.ConditionalForwarderIpAddrs = expandStringList(v.(*schema.Set).List())
+	}
+
+	log.Printf("[DEBUG] Creating DS trust with %q", d.Get("remote_domain_name").(string))
+	resp, err := dsconn.CreateTrust(input)
+	if err != nil {
+		return fmt.Errorf("Error creating DS trust: %s", err)
+	}
+
+	d.SetId(*resp.TrustId)
+
+	target := []string{directoryservice.TrustStateCreated, directoryservice.TrustStateVerified}
+	if err := waitForDirectoryServiceTrustState(dsconn, d.Id(), target, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	if d.Get("verify").(bool) {
+		log.Printf("[DEBUG] Verifying DS trust %q", d.Id())
+		_, err := dsconn.VerifyTrust(&directoryservice.VerifyTrustInput{
+			TrustId: aws.String(d.Id()),
+		})
+		if err != nil {
+			return fmt.Errorf("Error verifying DS trust %q: %s", d.Id(), err)
+		}
+
+		target := []string{directoryservice.TrustStateVerified}
+		if err := waitForDirectoryServiceTrustState(dsconn, d.Id(), target, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsDirectoryServiceTrustRead(d, meta)
+}
+
+func resourceAwsDirectoryServiceTrustRead(d *schema.ResourceData, meta interface{}) error {
+	dsconn := meta.(*AWSClient).dsconn
+
+	trust, err := describeDirectoryServiceTrust(dsconn, d.Id())
+	if err != nil {
+		if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+			log.Printf("[WARN] DS trust %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading DS trust %q: %s", d.Id(), err)
+	}
+
+	if trust == nil || aws.StringValue(trust.TrustState) == directoryservice.TrustStateDeleted {
+		log.Printf("[WARN] DS trust %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("directory_id", trust.DirectoryId)
+	d.Set("remote_domain_name", trust.RemoteDomainName)
+	d.Set("trust_direction", trust.TrustDirection)
+	d.Set("trust_type", trust.TrustType)
+	d.Set("trust_state", trust.TrustState)
+	d.Set("trust_state_reason", trust.TrustStateReason)
+
+	return nil
+}
+
+func resourceAwsDirectoryServiceTrustDelete(d *schema.ResourceData, meta interface{}) error {
+	dsconn := meta.(*AWSClient).dsconn
+
+	input := &directoryservice.DeleteTrustInput{
+		TrustId:                              aws.String(d.Id()),
+		DeleteAssociatedConditionalForwarder: aws.Bool(d.Get("delete_associated_conditional_forwarder").(bool)),
+	}
+
+	log.Printf("[DEBUG] Deleting DS trust: %s", input)
+	_, err := dsconn.DeleteTrust(input)
+	if err != nil {
+		if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting DS trust %q: %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			directoryservice.TrustStateCreated,
+			directoryservice.TrustStateDeleting,
+			directoryservice.TrustStateVerified,
+			directoryservice.TrustStateVerifyFailed,
+			directoryservice.TrustStateFailed,
+		},
+		Target:     []string{directoryservice.TrustStateDeleted},
+		Refresh:    directoryServiceTrustStateRefreshFunc(dsconn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for DS trust (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func waitForDirectoryServiceTrustState(dsconn *directoryservice.DirectoryService, trustId string, target []string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			directoryservice.TrustStateCreating,
+			directoryservice.TrustStateCreated,
+			directoryservice.TrustStateVerifying,
+		},
+		Target: target,
+		Refresh: func() (interface{}, string, error) {
+			trust, state, err := directoryServiceTrustStateRefreshFunc(dsconn, trustId)()
+			if err == nil && (state == directoryservice.TrustStateFailed || state == directoryservice.TrustStateVerifyFailed) {
+				reason := aws.StringValue(trust.(*directoryservice.Trust).TrustStateReason)
+				return trust, state, fmt.Errorf("%s: %s", state, reason)
+			}
+			return trust, state, err
+		},
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for DS trust (%s) to become %q: %s", trustId, target, err)
+	}
+
+	return nil
+}
+
+func directoryServiceTrustStateRefreshFunc(dsconn *directoryservice.DirectoryService, trustId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		trust, err := describeDirectoryServiceTrust(dsconn, trustId)
+		if err != nil {
+			if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+				return 42, directoryservice.TrustStateDeleted, nil
+			}
+			return nil, "", err
+		}
+		if trust == nil {
+			return 42, directoryservice.TrustStateDeleted, nil
+		}
+
+		return trust, aws.StringValue(trust.TrustState), nil
+	}
+}
+
+func describeDirectoryServiceTrust(dsconn *directoryservice.DirectoryService, trustId string) (*directoryservice.Trust, error) {
+	resp, err := dsconn.DescribeTrusts(&directoryservice.DescribeTrustsInput{
+		TrustIds: []*string{aws.String(trustId)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Trusts) == 0 {
+		return nil, nil
+	}
+	return resp.Trusts[0], nil
+}
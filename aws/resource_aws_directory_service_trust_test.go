@@ -0,0 +1,100 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDirectoryServiceTrust_basic(t *testing.T) {
+	domainName := os.Getenv("DS_TRUST_REMOTE_DOMAIN_NAME")
+	dnsIp := os.Getenv("DS_TRUST_REMOTE_DNS_IP")
+	password := os.Getenv("DS_TRUST_PASSWORD")
+	if domainName == "" || dnsIp == "" || password == "" {
+		t.Skip("Environment variables DS_TRUST_REMOTE_DOMAIN_NAME, DS_TRUST_REMOTE_DNS_IP and DS_TRUST_PASSWORD must be set")
+	}
+
+	resourceName := "aws_directory_service_trust.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDirectoryServiceTrustDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryServiceTrustConfig(domainName, dnsIp, password),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDirectoryServiceTrustExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "remote_domain_name", domainName),
+					resource.TestCheckResourceAttr(resourceName, "trust_direction", "One-Way: Outgoing"),
+					resource.TestCheckResourceAttr(resourceName, "trust_type", "Forest"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDirectoryServiceTrustDestroy(s *terraform.State) error {
+	dsconn := testAccProvider.Meta().(*AWSClient).dsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_directory_service_trust" {
+			continue
+		}
+
+		trust, err := describeDirectoryServiceTrust(dsconn, rs.Primary.ID)
+		if err != nil {
+			if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+				continue
+			}
+			return err
+		}
+
+		if trust != nil && *trust.TrustState != directoryservice.TrustStateDeleted {
+			return fmt.Errorf("Expected DS trust %q to be gone, but was still found", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsDirectoryServiceTrustExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		dsconn := testAccProvider.Meta().(*AWSClient).dsconn
+		trust, err := describeDirectoryServiceTrust(dsconn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if trust == nil {
+			return fmt.Errorf("No DS trust found")
+		}
+
+		return nil
+	}
+}
+
+func testAccDirectoryServiceTrustConfig(domainName, dnsIp, password string) string {
+	return testAccDirectoryServiceDirectoryConfig_microsoft + fmt.Sprintf(`
+resource "aws_directory_service_trust" "test" {
+  directory_id                            = "${aws_directory_service_directory.bar.id}"
+  remote_domain_name                      = "%s"
+  trust_direction                         = "One-Way: Outgoing"
+  trust_password                          = "%s"
+  conditional_forwarder_ip_addrs          = ["%s"]
+  delete_associated_conditional_forwarder = true
+}
+`, domainName, password, dnsIp)
+}
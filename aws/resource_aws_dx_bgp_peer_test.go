@@ -0,0 +1,98 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsDxBgpPeer_basic(t *testing.T) {
+	connectionId := os.Getenv("DX_CONNECTION_ID")
+	if connectionId == "" {
+		t.Skip("Environment variable DX_CONNECTION_ID is not set")
+	}
+
+	vifName := fmt.Sprintf("terraform-testacc-dxvif-%s", acctest.RandString(5))
+	bgpAsn := acctest.RandIntRange(64512, 65534)
+	vlan := acctest.RandIntRange(2049, 4094)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDxBgpPeerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDxBgpPeerConfig(connectionId, vifName, bgpAsn, vlan),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDxBgpPeerExists("aws_dx_bgp_peer.foo"),
+					resource.TestCheckResourceAttr("aws_dx_bgp_peer.foo", "address_family", "ipv6"),
+					resource.TestCheckResourceAttrSet("aws_dx_bgp_peer.foo", "bgp_status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDxBgpPeerDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dxconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dx_bgp_peer" {
+			continue
+		}
+
+		asn, _ := strconv.Atoi(rs.Primary.Attributes["bgp_asn"])
+		_, state, err := dxBgpPeerStateRefresh(conn, rs.Primary.Attributes["virtual_interface_id"], rs.Primary.Attributes["address_family"], int64(asn))()
+		if err != nil {
+			return err
+		}
+		if state != directconnect.BGPPeerStateDeleted {
+			return fmt.Errorf("[DESTROY ERROR] DX BGP peer (%s) not deleted", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckAwsDxBgpPeerExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		return nil
+	}
+}
+
+func testAccDxBgpPeerConfig(connectionId, vifName string, bgpAsn, vlan int) string {
+	return fmt.Sprintf(`
+resource "aws_vpn_gateway" "foo" {
+  tags {
+    Name = "%[2]s"
+  }
+}
+
+resource "aws_dx_private_virtual_interface" "foo" {
+  connection_id = "%[1]s"
+
+  vpn_gateway_id = "${aws_vpn_gateway.foo.id}"
+  name           = "%[2]s"
+  vlan           = %[4]d
+  address_family = "ipv4"
+  bgp_asn        = %[3]d
+}
+
+resource "aws_dx_bgp_peer" "foo" {
+  virtual_interface_id = "${aws_dx_private_virtual_interface.foo.id}"
+
+  address_family = "ipv6"
+  bgp_asn        = %[3]d
+}
+`, connectionId, vifName, bgpAsn, vlan)
+}
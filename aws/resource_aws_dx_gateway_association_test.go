@@ -0,0 +1,95 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsDxGatewayAssociation_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDxGatewayAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDxGatewayAssociationConfig(acctest.RandString(5), acctest.RandIntRange(64512, 65534)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDxGatewayAssociationExists("aws_dx_gateway_association.test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDxGatewayAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dxconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dx_gateway_association" {
+			continue
+		}
+
+		resp, err := conn.DescribeDirectConnectGatewayAssociations(&directconnect.DescribeDirectConnectGatewayAssociationsInput{
+			DirectConnectGatewayId: aws.String(rs.Primary.Attributes["dx_gateway_id"]),
+			VirtualGatewayId:       aws.String(rs.Primary.Attributes["vpn_gateway_id"]),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.DirectConnectGatewayAssociations) > 0 {
+			return fmt.Errorf("[DESTROY ERROR] DX Gateway association (%s) not deleted", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckAwsDxGatewayAssociationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		return nil
+	}
+}
+
+func testAccDxGatewayAssociationConfig(rName string, rBgpAsn int) string {
+	return fmt.Sprintf(`
+resource "aws_dx_gateway" "test" {
+  name            = "terraform-testacc-dxgwassoc-%s"
+  amazon_side_asn = "%d"
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.255.255.0/28"
+
+  tags {
+    Name = "terraform-testacc-dxgwassoc-%s"
+  }
+}
+
+resource "aws_vpn_gateway" "test" {
+  tags {
+    Name = "terraform-testacc-dxgwassoc-%s"
+  }
+}
+
+resource "aws_vpn_gateway_attachment" "test" {
+  vpc_id         = "${aws_vpc.test.id}"
+  vpn_gateway_id = "${aws_vpn_gateway.test.id}"
+}
+
+resource "aws_dx_gateway_association" "test" {
+  dx_gateway_id  = "${aws_dx_gateway.test.id}"
+  vpn_gateway_id = "${aws_vpn_gateway_attachment.test.vpn_gateway_id}"
+}
+`, rName, rBgpAsn, rName, rName)
+}
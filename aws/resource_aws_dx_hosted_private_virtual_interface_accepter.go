@@ -0,0 +1,133 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDxHostedPrivateVirtualInterfaceAccepter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDxHostedPrivateVirtualInterfaceAccepterCreate,
+		Read:   resourceAwsDxHostedPrivateVirtualInterfaceAccepterRead,
+		Delete: resourceAwsDxHostedPrivateVirtualInterfaceAccepterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"virtual_interface_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpn_gateway_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"dx_gateway_id"},
+			},
+			"dx_gateway_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"vpn_gateway_id"},
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceAwsDxHostedPrivateVirtualInterfaceAccepterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	vgwIdRaw, vgwOk := d.GetOk("vpn_gateway_id")
+	dxgwIdRaw, dxgwOk := d.GetOk("dx_gateway_id")
+	if vgwOk == dxgwOk {
+		return fmt.Errorf("One of ['vpn_gateway_id', 'dx_gateway_id'] must be set to accept a Direct Connect hosted private virtual interface")
+	}
+
+	vifId := d.Get("virtual_interface_id").(string)
+	req := &directconnect.ConfirmPrivateVirtualInterfaceInput{
+		VirtualInterfaceId: aws.String(vifId),
+	}
+	if vgwOk && vgwIdRaw.(string) != "" {
+		req.VirtualGatewayId = aws.String(vgwIdRaw.(string))
+	}
+	if dxgwOk && dxgwIdRaw.(string) != "" {
+		req.DirectConnectGatewayId = aws.String(dxgwIdRaw.(string))
+	}
+
+	log.Printf("[DEBUG] Accepting Direct Connect hosted private virtual interface: %#v", req)
+	_, err := conn.ConfirmPrivateVirtualInterface(req)
+	if err != nil {
+		return fmt.Errorf("Error accepting Direct Connect hosted private virtual interface (%s): %s", vifId, err)
+	}
+
+	d.SetId(vifId)
+
+	if err := dxHostedPrivateVirtualInterfaceAccepterWaitUntilAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceAwsDxHostedPrivateVirtualInterfaceAccepterRead(d, meta)
+}
+
+func resourceAwsDxHostedPrivateVirtualInterfaceAccepterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	vif, err := dxVirtualInterfaceRead(d.Id(), conn)
+	if err != nil {
+		return err
+	}
+	if vif == nil {
+		log.Printf("[WARN] Direct Connect virtual interface (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	vifState := aws.StringValue(vif.VirtualInterfaceState)
+	if vifState != directconnect.VirtualInterfaceStateAvailable &&
+		vifState != directconnect.VirtualInterfaceStateDown {
+		log.Printf("[WARN] Direct Connect virtual interface (%s) is '%s', removing from state", d.Id(), vifState)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("virtual_interface_id", vif.VirtualInterfaceId)
+	d.Set("vpn_gateway_id", vif.VirtualGatewayId)
+	d.Set("dx_gateway_id", vif.DirectConnectGatewayId)
+	d.Set("arn", dxVirtualInterfaceArn(meta.(*AWSClient), d.Id()))
+
+	return nil
+}
+
+func resourceAwsDxHostedPrivateVirtualInterfaceAccepterDelete(d *schema.ResourceData, meta interface{}) error {
+	return dxVirtualInterfaceDelete(d, meta)
+}
+
+func dxHostedPrivateVirtualInterfaceAccepterWaitUntilAvailable(conn *directconnect.DirectConnect, vifId string, timeout time.Duration) error {
+	return dxVirtualInterfaceWaitUntilAvailable(
+		conn,
+		vifId,
+		timeout,
+		[]string{
+			directconnect.VirtualInterfaceStateConfirming,
+			directconnect.VirtualInterfaceStatePending,
+		},
+		[]string{
+			directconnect.VirtualInterfaceStateAvailable,
+			directconnect.VirtualInterfaceStateDown,
+		})
+}
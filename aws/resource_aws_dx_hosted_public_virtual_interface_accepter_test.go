@@ -0,0 +1,71 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsDxHostedPublicVirtualInterfaceAccepter_basic(t *testing.T) {
+	vifId := os.Getenv("DX_HOSTED_VIF_ID")
+	if vifId == "" {
+		t.Skip("Environment variable DX_HOSTED_VIF_ID is not set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDxHostedPublicVirtualInterfaceAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDxHostedPublicVirtualInterfaceAccepterConfig(vifId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDxHostedPublicVirtualInterfaceAccepterExists("aws_dx_hosted_public_virtual_interface_accepter.foo"),
+					resource.TestCheckResourceAttr("aws_dx_hosted_public_virtual_interface_accepter.foo", "virtual_interface_id", vifId),
+					resource.TestCheckResourceAttrSet("aws_dx_hosted_public_virtual_interface_accepter.foo", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDxHostedPublicVirtualInterfaceAccepterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dxconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dx_hosted_public_virtual_interface_accepter" {
+			continue
+		}
+
+		vif, err := dxVirtualInterfaceRead(rs.Primary.ID, conn)
+		if err != nil {
+			return err
+		}
+		if vif != nil {
+			return fmt.Errorf("[DESTROY ERROR] DX virtual interface (%s) not deleted", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckAwsDxHostedPublicVirtualInterfaceAccepterExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		return nil
+	}
+}
+
+func testAccDxHostedPublicVirtualInterfaceAccepterConfig(vifId string) string {
+	return fmt.Sprintf(`
+resource "aws_dx_hosted_public_virtual_interface_accepter" "foo" {
+  virtual_interface_id = "%s"
+}
+`, vifId)
+}
@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsDxHostedPublicVirtualInterface_basic(t *testing.T) {
+	connectionId := os.Getenv("DX_CONNECTION_ID")
+	if connectionId == "" {
+		t.Skip("Environment variable DX_CONNECTION_ID is not set")
+	}
+	ownerAccountId := os.Getenv("DX_HOSTED_VIF_OWNER_ACCOUNT")
+	if ownerAccountId == "" {
+		t.Skip("Environment variable DX_HOSTED_VIF_OWNER_ACCOUNT is not set")
+	}
+
+	vifName := fmt.Sprintf("terraform-testacc-dxvif-%s", acctest.RandString(5))
+	bgpAsn := acctest.RandIntRange(64512, 65534)
+	vlan := acctest.RandIntRange(2049, 4094)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDxHostedPublicVirtualInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDxHostedPublicVirtualInterfaceConfig(connectionId, ownerAccountId, vifName, bgpAsn, vlan),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDxHostedPublicVirtualInterfaceExists("aws_dx_hosted_public_virtual_interface.foo"),
+					resource.TestCheckResourceAttr("aws_dx_hosted_public_virtual_interface.foo", "name", vifName),
+					resource.TestCheckResourceAttr("aws_dx_hosted_public_virtual_interface.foo", "owner_account_id", ownerAccountId),
+					resource.TestCheckResourceAttr("aws_dx_hosted_public_virtual_interface.foo", "route_filter_prefixes.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "aws_dx_hosted_public_virtual_interface.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDxHostedPublicVirtualInterfaceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dxconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dx_hosted_public_virtual_interface" {
+			continue
+		}
+
+		input := &directconnect.DescribeVirtualInterfacesInput{
+			VirtualInterfaceId: aws.String(rs.Primary.ID),
+		}
+
+		resp, err := conn.DescribeVirtualInterfaces(input)
+		if err != nil {
+			return err
+		}
+		for _, v := range resp.VirtualInterfaces {
+			if *v.VirtualInterfaceId == rs.Primary.ID && !(*v.VirtualInterfaceState == directconnect.VirtualInterfaceStateDeleted) {
+				return fmt.Errorf("[DESTROY ERROR] DX virtual interface (%s) not deleted", rs.Primary.ID)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckAwsDxHostedPublicVirtualInterfaceExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		return nil
+	}
+}
+
+func testAccDxHostedPublicVirtualInterfaceConfig(connectionId, ownerAccountId, vifName string, bgpAsn, vlan int) string {
+	return fmt.Sprintf(`
+resource "aws_dx_hosted_public_virtual_interface" "foo" {
+  connection_id    = "%[1]s"
+  owner_account_id = "%[2]s"
+
+  name           = "%[3]s"
+  vlan           = %[5]d
+  address_family = "ipv4"
+  bgp_asn        = %[4]d
+
+  customer_address = "175.45.176.1/30"
+  amazon_address   = "175.45.176.2/30"
+
+  route_filter_prefixes = [
+    "210.52.109.0/24",
+    "175.45.176.0/22",
+  ]
+}
+`, connectionId, ownerAccountId, vifName, bgpAsn, vlan)
+}
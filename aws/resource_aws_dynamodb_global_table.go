@@ -0,0 +1,327 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDynamoDbGlobalTable() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDynamoDbGlobalTableCreate,
+		Read:   resourceAwsDynamoDbGlobalTableRead,
+		Update: resourceAwsDynamoDbGlobalTableUpdate,
+		Delete: resourceAwsDynamoDbGlobalTableDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsDynamoDbTableName,
+			},
+
+			"replica": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDynamoDbGlobalTableCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	globalTableName := d.Get("name").(string)
+
+	input := &dynamodb.CreateGlobalTableInput{
+		GlobalTableName:  aws.String(globalTableName),
+		ReplicationGroup: expandAwsDynamoDbReplicas(d.Get("replica").(*schema.Set).List()),
+	}
+
+	log.Printf("[DEBUG] Creating DynamoDB Global Table: %s", input)
+	_, err := conn.CreateGlobalTable(input)
+	if err != nil {
+		return fmt.Errorf("Error creating DynamoDB Global Table %q: %s", globalTableName, err)
+	}
+
+	d.SetId(globalTableName)
+
+	log.Println("[INFO] Waiting for DynamoDB Global Table to be created")
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			dynamodb.GlobalTableStatusCreating,
+			dynamodb.GlobalTableStatusDeleting,
+			dynamodb.GlobalTableStatusUpdating,
+		},
+		Target: []string{
+			dynamodb.GlobalTableStatusActive,
+		},
+		Refresh:    resourceAwsDynamoDbGlobalTableStateRefreshFunc(d, meta),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for DynamoDB Global Table %q to become active: %s", d.Id(), err)
+	}
+
+	return resourceAwsDynamoDbGlobalTableRead(d, meta)
+}
+
+func resourceAwsDynamoDbGlobalTableRead(d *schema.ResourceData, meta interface{}) error {
+	globalTableDescription, err := resourceAwsDynamoDbGlobalTableRetrieve(d, meta)
+	if err != nil {
+		return err
+	}
+
+	if globalTableDescription == nil {
+		log.Printf("[WARN] DynamoDB Global Table %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", globalTableDescription.GlobalTableName)
+	d.Set("arn", globalTableDescription.GlobalTableArn)
+
+	if err := d.Set("replica", flattenAwsDynamoDbReplicas(globalTableDescription.ReplicationGroup)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAwsDynamoDbGlobalTableUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	if d.HasChange("replica") {
+		o, n := d.GetChange("replica")
+		if o == nil {
+			o = new(schema.Set)
+		}
+		if n == nil {
+			n = new(schema.Set)
+		}
+
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+		replicaUpdateCreateReplicas := expandAwsDynamoDbReplicaUpdateCreateReplicas(ns.Difference(os).List())
+		replicaUpdateDeleteReplicas := expandAwsDynamoDbReplicaUpdateDeleteReplicas(os.Difference(ns).List())
+
+		replicaUpdates := make([]*dynamodb.ReplicaUpdate, 0, len(replicaUpdateCreateReplicas)+len(replicaUpdateDeleteReplicas))
+		replicaUpdates = append(replicaUpdates, replicaUpdateCreateReplicas...)
+		replicaUpdates = append(replicaUpdates, replicaUpdateDeleteReplicas...)
+
+		// DynamoDB only accepts a single replica change per UpdateGlobalTable call,
+		// so each addition and removal is applied and waited on in turn.
+		for _, replicaUpdate := range replicaUpdates {
+			input := &dynamodb.UpdateGlobalTableInput{
+				GlobalTableName: aws.String(d.Id()),
+				ReplicaUpdates:  []*dynamodb.ReplicaUpdate{replicaUpdate},
+			}
+			log.Printf("[DEBUG] Updating DynamoDB Global Table: %s", input)
+			if _, err := conn.UpdateGlobalTable(input); err != nil {
+				return fmt.Errorf("Error updating DynamoDB Global Table %q: %s", d.Id(), err)
+			}
+
+			log.Println("[INFO] Waiting for DynamoDB Global Table to be updated")
+			stateConf := &resource.StateChangeConf{
+				Pending: []string{
+					dynamodb.GlobalTableStatusCreating,
+					dynamodb.GlobalTableStatusDeleting,
+					dynamodb.GlobalTableStatusUpdating,
+				},
+				Target: []string{
+					dynamodb.GlobalTableStatusActive,
+				},
+				Refresh:    resourceAwsDynamoDbGlobalTableStateRefreshFunc(d, meta),
+				Timeout:    d.Timeout(schema.TimeoutUpdate),
+				MinTimeout: 10 * time.Second,
+			}
+			if _, err := stateConf.WaitForState(); err != nil {
+				return fmt.Errorf("Error waiting for DynamoDB Global Table %q to be updated: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceAwsDynamoDbGlobalTableRead(d, meta)
+}
+
+// Deleting a DynamoDB Global Table is represented by removing all replicas.
+func resourceAwsDynamoDbGlobalTableDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	// As with updates, replicas have to be removed one UpdateGlobalTable
+	// call at a time, waiting for the table to settle in between.
+	replicaUpdates := expandAwsDynamoDbReplicaUpdateDeleteReplicas(d.Get("replica").(*schema.Set).List())
+	for i, replicaUpdate := range replicaUpdates {
+		input := &dynamodb.UpdateGlobalTableInput{
+			GlobalTableName: aws.String(d.Id()),
+			ReplicaUpdates:  []*dynamodb.ReplicaUpdate{replicaUpdate},
+		}
+		log.Printf("[DEBUG] Deleting DynamoDB Global Table replica: %s", input)
+		if _, err := conn.UpdateGlobalTable(input); err != nil {
+			if isAWSErr(err, dynamodb.ErrCodeGlobalTableNotFoundException, "") {
+				return nil
+			}
+			return fmt.Errorf("Error deleting DynamoDB Global Table %q: %s", d.Id(), err)
+		}
+
+		if i == len(replicaUpdates)-1 {
+			break
+		}
+
+		log.Println("[INFO] Waiting for DynamoDB Global Table replica to be removed")
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{
+				dynamodb.GlobalTableStatusCreating,
+				dynamodb.GlobalTableStatusDeleting,
+				dynamodb.GlobalTableStatusUpdating,
+			},
+			Target: []string{
+				dynamodb.GlobalTableStatusActive,
+			},
+			Refresh:    resourceAwsDynamoDbGlobalTableStateRefreshFunc(d, meta),
+			Timeout:    d.Timeout(schema.TimeoutDelete),
+			MinTimeout: 10 * time.Second,
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("Error waiting for DynamoDB Global Table %q replica to be removed: %s", d.Id(), err)
+		}
+	}
+
+	log.Println("[INFO] Waiting for DynamoDB Global Table to be destroyed")
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			dynamodb.GlobalTableStatusActive,
+			dynamodb.GlobalTableStatusCreating,
+			dynamodb.GlobalTableStatusDeleting,
+			dynamodb.GlobalTableStatusUpdating,
+		},
+		Target:     []string{},
+		Refresh:    resourceAwsDynamoDbGlobalTableStateRefreshFunc(d, meta),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for DynamoDB Global Table %q to be destroyed: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsDynamoDbGlobalTableRetrieve(d *schema.ResourceData, meta interface{}) (*dynamodb.GlobalTableDescription, error) {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	input := &dynamodb.DescribeGlobalTableInput{
+		GlobalTableName: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Retrieving DynamoDB Global Table: %s", input)
+
+	output, err := conn.DescribeGlobalTable(input)
+	if err != nil {
+		if isAWSErr(err, dynamodb.ErrCodeGlobalTableNotFoundException, "") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Error retrieving DynamoDB Global Table %q: %s", d.Id(), err)
+	}
+
+	return output.GlobalTableDescription, nil
+}
+
+func resourceAwsDynamoDbGlobalTableStateRefreshFunc(
+	d *schema.ResourceData, meta interface{}) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		gtd, err := resourceAwsDynamoDbGlobalTableRetrieve(d, meta)
+
+		if err != nil {
+			log.Printf("Error on retrieving DynamoDB Global Table when waiting: %s", err)
+			return nil, "", err
+		}
+
+		if gtd == nil {
+			return nil, "", nil
+		}
+
+		if gtd.GlobalTableStatus != nil {
+			log.Printf("[DEBUG] Status for DynamoDB Global Table %s: %s", d.Id(), *gtd.GlobalTableStatus)
+		}
+
+		return gtd, aws.StringValue(gtd.GlobalTableStatus), nil
+	}
+}
+
+func expandAwsDynamoDbReplicaUpdateCreateReplicas(configuredReplicas []interface{}) []*dynamodb.ReplicaUpdate {
+	replicaUpdates := make([]*dynamodb.ReplicaUpdate, 0, len(configuredReplicas))
+	for _, replicaRaw := range configuredReplicas {
+		replica := replicaRaw.(map[string]interface{})
+		replicaUpdates = append(replicaUpdates, &dynamodb.ReplicaUpdate{
+			Create: &dynamodb.CreateReplicaAction{
+				RegionName: aws.String(replica["region_name"].(string)),
+			},
+		})
+	}
+	return replicaUpdates
+}
+
+func expandAwsDynamoDbReplicaUpdateDeleteReplicas(configuredReplicas []interface{}) []*dynamodb.ReplicaUpdate {
+	replicaUpdates := make([]*dynamodb.ReplicaUpdate, 0, len(configuredReplicas))
+	for _, replicaRaw := range configuredReplicas {
+		replica := replicaRaw.(map[string]interface{})
+		replicaUpdates = append(replicaUpdates, &dynamodb.ReplicaUpdate{
+			Delete: &dynamodb.DeleteReplicaAction{
+				RegionName: aws.String(replica["region_name"].(string)),
+			},
+		})
+	}
+	return replicaUpdates
+}
+
+func expandAwsDynamoDbReplicas(configuredReplicas []interface{}) []*dynamodb.Replica {
+	replicas := make([]*dynamodb.Replica, 0, len(configuredReplicas))
+	for _, replicaRaw := range configuredReplicas {
+		replica := replicaRaw.(map[string]interface{})
+		replicas = append(replicas, &dynamodb.Replica{
+			RegionName: aws.String(replica["region_name"].(string)),
+		})
+	}
+	return replicas
+}
+
+func flattenAwsDynamoDbReplicas(replicaDescriptions []*dynamodb.ReplicaDescription) []interface{} {
+	replicas := []interface{}{}
+	for _, replicaDescription := range replicaDescriptions {
+		replica := map[string]interface{}{
+			"region_name": aws.StringValue(replicaDescription.RegionName),
+		}
+		replicas = append(replicas, replica)
+	}
+	return replicas
+}
@@ -0,0 +1,330 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDynamoDbGlobalTable_basic(t *testing.T) {
+	resourceName := "aws_dynamodb_global_table.test"
+	tableName := fmt.Sprintf("tf-acc-test-global-table-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccDynamoDbGlobalTablePreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDynamoDbGlobalTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDynamoDbGlobalTableConfig_invalidName(acctest.RandString(2)),
+				ExpectError: regexp.MustCompile("name length must be between 3 and 255 characters"),
+			},
+			{
+				Config: testAccDynamoDbGlobalTableConfig_basic(tableName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDynamoDbGlobalTableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", tableName),
+					resource.TestCheckResourceAttr(resourceName, "replica.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "arn",
+						regexp.MustCompile("^arn:aws:dynamodb::[0-9]{12}:global-table/[a-z0-9-]+$")),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDynamoDbGlobalTable_multipleRegions(t *testing.T) {
+	resourceName := "aws_dynamodb_global_table.test"
+	tableName := fmt.Sprintf("tf-acc-test-global-table-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			// The existence and destroy checks use the default provider.
+			if region := testAccProvider.Meta().(*AWSClient).region; region != "us-east-1" {
+				t.Skipf("Skipping test; requires us-east-1 as the default region, got %s", region)
+			}
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDynamoDbGlobalTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDynamoDbGlobalTableConfig_multipleRegions1(tableName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDynamoDbGlobalTableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "replica.#", "1"),
+				),
+			},
+			{
+				Config: testAccDynamoDbGlobalTableConfig_multipleRegions2(tableName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDynamoDbGlobalTableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "replica.#", "2"),
+				),
+			},
+			{
+				Config: testAccDynamoDbGlobalTableConfig_multipleRegions1(tableName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDynamoDbGlobalTableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "replica.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDynamoDbGlobalTable_multipleRegionsDestroy(t *testing.T) {
+	resourceName := "aws_dynamodb_global_table.test"
+	tableName := fmt.Sprintf("tf-acc-test-global-table-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			// The existence and destroy checks use the default provider.
+			if region := testAccProvider.Meta().(*AWSClient).region; region != "us-east-1" {
+				t.Skipf("Skipping test; requires us-east-1 as the default region, got %s", region)
+			}
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDynamoDbGlobalTableDestroy,
+		Steps: []resource.TestStep{
+			// Destroying straight from two replicas removes them one at a time
+			{
+				Config: testAccDynamoDbGlobalTableConfig_multipleRegions2(tableName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDynamoDbGlobalTableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "replica.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDynamoDbGlobalTableDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dynamodb_global_table" {
+			continue
+		}
+
+		input := &dynamodb.DescribeGlobalTableInput{
+			GlobalTableName: aws.String(rs.Primary.ID),
+		}
+
+		_, err := conn.DescribeGlobalTable(input)
+		if err != nil {
+			if isAWSErr(err, dynamodb.ErrCodeGlobalTableNotFoundException, "") {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Expected DynamoDB Global Table to be destroyed, %s found", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsDynamoDbGlobalTableExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+
+		_, err := conn.DescribeGlobalTable(&dynamodb.DescribeGlobalTableInput{
+			GlobalTableName: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccDynamoDbGlobalTablePreCheck(t *testing.T) {
+	supportedRegions := []string{"ap-northeast-1", "ap-northeast-2", "ap-southeast-1", "ap-southeast-2", "eu-central-1", "eu-west-1", "eu-west-2", "us-east-1", "us-east-2", "us-west-1", "us-west-2"}
+
+	region := testAccProvider.Meta().(*AWSClient).region
+	for _, supported := range supportedRegions {
+		if region == supported {
+			return
+		}
+	}
+
+	t.Skipf("DynamoDB Global Tables are not supported in %s", region)
+}
+
+func testAccDynamoDbGlobalTableConfig_basic(tableName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {
+  current = true
+}
+
+resource "aws_dynamodb_table" "test" {
+  hash_key         = "myAttribute"
+  name             = "%s"
+  stream_enabled   = true
+  stream_view_type = "NEW_AND_OLD_IMAGES"
+  read_capacity    = 1
+  write_capacity   = 1
+
+  attribute {
+    name = "myAttribute"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_global_table" "test" {
+  depends_on = ["aws_dynamodb_table.test"]
+
+  name = "%s"
+
+  replica {
+    region_name = "${data.aws_region.current.name}"
+  }
+}
+`, tableName, tableName)
+}
+
+func testAccDynamoDbGlobalTableConfig_multipleRegions1(tableName string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  alias  = "us-east-1"
+  region = "us-east-1"
+}
+
+provider "aws" {
+  alias  = "us-west-2"
+  region = "us-west-2"
+}
+
+resource "aws_dynamodb_table" "us-east-1" {
+  provider = "aws.us-east-1"
+
+  hash_key         = "myAttribute"
+  name             = "%s"
+  stream_enabled   = true
+  stream_view_type = "NEW_AND_OLD_IMAGES"
+  read_capacity    = 1
+  write_capacity   = 1
+
+  attribute {
+    name = "myAttribute"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_table" "us-west-2" {
+  provider = "aws.us-west-2"
+
+  hash_key         = "myAttribute"
+  name             = "%s"
+  stream_enabled   = true
+  stream_view_type = "NEW_AND_OLD_IMAGES"
+  read_capacity    = 1
+  write_capacity   = 1
+
+  attribute {
+    name = "myAttribute"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_global_table" "test" {
+  depends_on = ["aws_dynamodb_table.us-east-1", "aws_dynamodb_table.us-west-2"]
+  provider   = "aws.us-east-1"
+
+  name = "%s"
+
+  replica {
+    region_name = "us-east-1"
+  }
+}
+`, tableName, tableName, tableName)
+}
+
+func testAccDynamoDbGlobalTableConfig_multipleRegions2(tableName string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  alias  = "us-east-1"
+  region = "us-east-1"
+}
+
+provider "aws" {
+  alias  = "us-west-2"
+  region = "us-west-2"
+}
+
+resource "aws_dynamodb_table" "us-east-1" {
+  provider = "aws.us-east-1"
+
+  hash_key         = "myAttribute"
+  name             = "%s"
+  stream_enabled   = true
+  stream_view_type = "NEW_AND_OLD_IMAGES"
+  read_capacity    = 1
+  write_capacity   = 1
+
+  attribute {
+    name = "myAttribute"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_table" "us-west-2" {
+  provider = "aws.us-west-2"
+
+  hash_key         = "myAttribute"
+  name             = "%s"
+  stream_enabled   = true
+  stream_view_type = "NEW_AND_OLD_IMAGES"
+  read_capacity    = 1
+  write_capacity   = 1
+
+  attribute {
+    name = "myAttribute"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_global_table" "test" {
+  depends_on = ["aws_dynamodb_table.us-east-1", "aws_dynamodb_table.us-west-2"]
+  provider   = "aws.us-east-1"
+
+  name = "%s"
+
+  replica {
+    region_name = "us-east-1"
+  }
+
+  replica {
+    region_name = "us-west-2"
+  }
+}
+`, tableName, tableName, tableName)
+}
+
+func testAccDynamoDbGlobalTableConfig_invalidName(tableName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_global_table" "test" {
+  name = "%s"
+
+  replica {
+    region_name = "us-east-1"
+  }
+}
+`, tableName)
+}
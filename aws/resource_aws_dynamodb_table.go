@@ -202,6 +202,12 @@ func resourceAwsDynamoDbTable() *schema.Resource {
 				Computed: true,
 			},
 			"tags": tagsSchema(),
+			"restore_from_backup_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
 		},
 	}
 }
@@ -211,6 +217,10 @@ func resourceAwsDynamoDbTableCreate(d *schema.ResourceData, meta interface{}) er
 
 	name := d.Get("name").(string)
 
+	if v, ok := d.GetOk("restore_from_backup_arn"); ok {
+		return resourceAwsDynamoDbTableRestore(d, meta, name, v.(string))
+	}
+
 	log.Printf("[DEBUG] DynamoDB table create: %s", name)
 
 	throughput := &dynamodb.ProvisionedThroughput{
@@ -386,6 +396,94 @@ func resourceAwsDynamoDbTableCreate(d *schema.ResourceData, meta interface{}) er
 	return fmt.Errorf("Unable to create DynamoDB table '%s' after %d attempts", name, attemptCount)
 }
 
+// resourceAwsDynamoDbTableRestore creates the table from an on-demand backup.
+// Key schema, indexes and attribute definitions are taken from the backup;
+// throughput, streams, TTL and tags are then reconciled with the configuration.
+func resourceAwsDynamoDbTableRestore(d *schema.ResourceData, meta interface{}, name, backupArn string) error {
+	dynamodbconn := meta.(*AWSClient).dynamodbconn
+
+	req := &dynamodb.RestoreTableFromBackupInput{
+		BackupArn:       aws.String(backupArn),
+		TargetTableName: aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Restoring DynamoDB table: %s", req)
+	var output *dynamodb.RestoreTableFromBackupOutput
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		var err error
+		output, err = dynamodbconn.RestoreTableFromBackup(req)
+		if err != nil {
+			if isAWSErr(err, "ThrottlingException", "") {
+				return resource.RetryableError(err)
+			}
+			if isAWSErr(err, dynamodb.ErrCodeLimitExceededException, "can be created, updated, or deleted simultaneously") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error restoring DynamoDB table %q from backup %q: %s", name, backupArn, err)
+	}
+
+	d.SetId(*output.TableDescription.TableName)
+	d.Set("arn", output.TableDescription.TableArn)
+
+	if err := waitForTableToBeActive(d.Id(), meta); err != nil {
+		return err
+	}
+
+	update := &dynamodb.UpdateTableInput{
+		TableName: aws.String(d.Id()),
+	}
+	needsUpdate := false
+
+	throughput := output.TableDescription.ProvisionedThroughput
+	if throughput == nil ||
+		aws.Int64Value(throughput.ReadCapacityUnits) != int64(d.Get("read_capacity").(int)) ||
+		aws.Int64Value(throughput.WriteCapacityUnits) != int64(d.Get("write_capacity").(int)) {
+		update.ProvisionedThroughput = &dynamodb.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(int64(d.Get("read_capacity").(int))),
+			WriteCapacityUnits: aws.Int64(int64(d.Get("write_capacity").(int))),
+		}
+		needsUpdate = true
+	}
+
+	if d.Get("stream_enabled").(bool) {
+		update.StreamSpecification = &dynamodb.StreamSpecification{
+			StreamEnabled:  aws.Bool(true),
+			StreamViewType: aws.String(d.Get("stream_view_type").(string)),
+		}
+		needsUpdate = true
+	}
+
+	if needsUpdate {
+		log.Printf("[DEBUG] Updating restored DynamoDB table: %s", update)
+		if _, err := dynamodbconn.UpdateTable(update); err != nil {
+			return fmt.Errorf("Error updating restored DynamoDB table %q: %s", d.Id(), err)
+		}
+
+		if err := waitForTableToBeActive(d.Id(), meta); err != nil {
+			return err
+		}
+	}
+
+	if _, ok := d.GetOk("ttl"); ok {
+		if err := updateTimeToLive(d, meta); err != nil {
+			return err
+		}
+	}
+
+	if _, ok := d.GetOk("tags"); ok {
+		if err := createTableTags(d, meta); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsDynamoDbTableRead(d, meta)
+}
+
 func resourceAwsDynamoDbTableUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Updating DynamoDB table %s", d.Id())
@@ -706,6 +804,10 @@ func resourceAwsDynamoDbTableRead(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
+	if result.Table.RestoreSummary != nil && result.Table.RestoreSummary.SourceBackupArn != nil {
+		d.Set("restore_from_backup_arn", result.Table.RestoreSummary.SourceBackupArn)
+	}
+
 	return flattenAwsDynamoDbTableResource(d, meta, result.Table)
 }
 
@@ -0,0 +1,201 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDynamoDbTableBackup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDynamoDbTableBackupCreate,
+		Read:   resourceAwsDynamoDbTableBackupRead,
+		Delete: resourceAwsDynamoDbTableBackupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsDynamoDbTableName,
+			},
+			"table_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsDynamoDbTableName,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"table_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDynamoDbTableBackupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	input := &dynamodb.CreateBackupInput{
+		BackupName: aws.String(d.Get("name").(string)),
+		TableName:  aws.String(d.Get("table_name").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating DynamoDB table backup: %s", input)
+	var output *dynamodb.CreateBackupOutput
+	// A backup can't be requested while the table (or a previous backup of it) is still being set up.
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		var err error
+		output, err = conn.CreateBackup(input)
+		if err != nil {
+			if isAWSErr(err, dynamodb.ErrCodeTableInUseException, "") ||
+				isAWSErr(err, dynamodb.ErrCodeBackupInUseException, "") ||
+				isAWSErr(err, dynamodb.ErrCodeLimitExceededException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating DynamoDB table backup: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.BackupDetails.BackupArn))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{dynamodb.BackupStatusCreating},
+		Target:     []string{dynamodb.BackupStatusAvailable},
+		Refresh:    dynamoDbTableBackupStateRefresh(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for DynamoDB table backup (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceAwsDynamoDbTableBackupRead(d, meta)
+}
+
+func resourceAwsDynamoDbTableBackupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	output, err := conn.DescribeBackup(&dynamodb.DescribeBackupInput{
+		BackupArn: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, dynamodb.ErrCodeBackupNotFoundException, "") {
+			log.Printf("[WARN] DynamoDB table backup (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading DynamoDB table backup (%s): %s", d.Id(), err)
+	}
+
+	details := output.BackupDescription.BackupDetails
+	if aws.StringValue(details.BackupStatus) == dynamodb.BackupStatusDeleted {
+		log.Printf("[WARN] DynamoDB table backup (%s) deleted, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", details.BackupArn)
+	d.Set("name", details.BackupName)
+	d.Set("size_bytes", details.BackupSizeBytes)
+	d.Set("status", details.BackupStatus)
+	if details.BackupCreationDateTime != nil {
+		d.Set("creation_date", details.BackupCreationDateTime.Format(time.RFC3339))
+	}
+
+	if table := output.BackupDescription.SourceTableDetails; table != nil {
+		d.Set("table_name", table.TableName)
+		d.Set("table_arn", table.TableArn)
+	}
+
+	return nil
+}
+
+func resourceAwsDynamoDbTableBackupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	log.Printf("[DEBUG] Deleting DynamoDB table backup: %s", d.Id())
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.DeleteBackup(&dynamodb.DeleteBackupInput{
+			BackupArn: aws.String(d.Id()),
+		})
+		if err != nil {
+			if isAWSErr(err, dynamodb.ErrCodeBackupInUseException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		if isAWSErr(err, dynamodb.ErrCodeBackupNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting DynamoDB table backup (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{dynamodb.BackupStatusAvailable, dynamodb.BackupStatusCreating},
+		Target:     []string{dynamodb.BackupStatusDeleted},
+		Refresh:    dynamoDbTableBackupStateRefresh(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for DynamoDB table backup (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func dynamoDbTableBackupStateRefresh(conn *dynamodb.DynamoDB, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeBackup(&dynamodb.DescribeBackupInput{
+			BackupArn: aws.String(arn),
+		})
+		if err != nil {
+			if isAWSErr(err, dynamodb.ErrCodeBackupNotFoundException, "") {
+				return "", dynamodb.BackupStatusDeleted, nil
+			}
+			return nil, "", err
+		}
+
+		details := output.BackupDescription.BackupDetails
+		return details, aws.StringValue(details.BackupStatus), nil
+	}
+}
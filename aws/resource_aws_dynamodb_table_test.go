@@ -137,6 +137,31 @@ func TestAccAWSDynamoDbTable_ttl(t *testing.T) {
 		},
 	})
 }
+
+func TestAccAWSDynamoDbTable_restoreFromBackup(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-backup-%s", acctest.RandString(8))
+	resourceName := "aws_dynamodb_table.restored"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDynamoDbTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDynamoDbConfigRestoreFromBackup(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-restored"),
+					resource.TestCheckResourceAttr(resourceName, "hash_key", "TestTableHashKey"),
+					resource.TestCheckResourceAttr(resourceName, "read_capacity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "write_capacity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "restore_from_backup_arn", "aws_dynamodb_table_backup.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDynamoDbTableTimeToLiveWasUpdated(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		log.Printf("[DEBUG] Trying to create initial table state!")
@@ -797,3 +822,24 @@ resource "aws_dynamodb_table" "basic-dynamodb-table" {
 }
 `, rName)
 }
+
+func testAccAWSDynamoDbConfigRestoreFromBackup(rName string) string {
+	return testAccAWSDynamoDbTableBackupConfig(rName) + fmt.Sprintf(`
+resource "aws_dynamodb_table" "restored" {
+  name                    = "%s-restored"
+  read_capacity           = 2
+  write_capacity          = 2
+  hash_key                = "TestTableHashKey"
+  restore_from_backup_arn = "${aws_dynamodb_table_backup.test.arn}"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  tags {
+    Name = "%s-restored"
+  }
+}
+`, rName, rName)
+}
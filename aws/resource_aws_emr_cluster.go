@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"bytes"
 	"log"
 
 	"encoding/json"
@@ -12,9 +13,12 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
 	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsEMRCluster() *schema.Resource {
@@ -136,6 +140,12 @@ func resourceAwsEMRCluster() *schema.Resource {
 				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"autoscaling_policy": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: suppressEquivalentJsonDiffs,
+							ValidateFunc:     validateJsonString,
+						},
 						"bid_price": {
 							Type:     schema.TypeString,
 							Optional: true,
@@ -190,6 +200,7 @@ func resourceAwsEMRCluster() *schema.Resource {
 						},
 					},
 				},
+				Set: resourceAwsEMRClusterInstanceGroupHash,
 			},
 			"bootstrap_action": {
 				Type:     schema.TypeSet,
@@ -235,6 +246,16 @@ func resourceAwsEMRCluster() *schema.Resource {
 				ForceNew: true,
 				Optional: true,
 			},
+			"scale_down_behavior": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					emr.ScaleDownBehaviorTerminateAtInstanceHour,
+					emr.ScaleDownBehaviorTerminateAtTaskCompletion,
+				}, false),
+			},
 			"visible_to_all_users": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -328,7 +349,11 @@ func resourceAwsEMRClusterCreate(d *schema.ResourceData, meta interface{}) error
 	}
 	if v, ok := d.GetOk("instance_group"); ok {
 		instanceGroupConfigs := v.(*schema.Set).List()
-		instanceConfig.InstanceGroups = expandInstanceGroupConfigs(instanceGroupConfigs)
+		instanceGroups, err := expandInstanceGroupConfigs(instanceGroupConfigs)
+		if err != nil {
+			return err
+		}
+		instanceConfig.InstanceGroups = instanceGroups
 	}
 
 	emrApps := expandApplications(applications)
@@ -350,6 +375,10 @@ func resourceAwsEMRClusterCreate(d *schema.ResourceData, meta interface{}) error
 		params.AutoScalingRole = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("scale_down_behavior"); ok {
+		params.ScaleDownBehavior = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("security_configuration"); ok {
 		params.SecurityConfiguration = aws.String(v.(string))
 	}
@@ -447,7 +476,11 @@ func resourceAwsEMRClusterRead(d *schema.ResourceData, meta interface{}) error {
 		if coreGroup != nil {
 			d.Set("core_instance_type", coreGroup.InstanceType)
 		}
-		if err := d.Set("instance_group", flattenInstanceGroups(instanceGroups)); err != nil {
+		flattenedInstanceGroups, err := flattenInstanceGroups(instanceGroups)
+		if err != nil {
+			return fmt.Errorf("Error flattening EMR instance groups: %s", err)
+		}
+		if err := d.Set("instance_group", flattenedInstanceGroups); err != nil {
 			log.Printf("[ERR] Error setting EMR instance groups: %s", err)
 		}
 	}
@@ -456,6 +489,7 @@ func resourceAwsEMRClusterRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("service_role", cluster.ServiceRole)
 	d.Set("security_configuration", cluster.SecurityConfiguration)
 	d.Set("autoscaling_role", cluster.AutoScalingRole)
+	d.Set("scale_down_behavior", cluster.ScaleDownBehavior)
 	d.Set("release_label", cluster.ReleaseLabel)
 	d.Set("log_uri", cluster.LogUri)
 	d.Set("master_public_dns", cluster.MasterPublicDnsName)
@@ -696,11 +730,16 @@ func flattenEc2Attributes(ia *emr.Ec2InstanceAttributes) []map[string]interface{
 	return result
 }
 
-func flattenInstanceGroups(igs []*emr.InstanceGroup) []map[string]interface{} {
+func flattenInstanceGroups(igs []*emr.InstanceGroup) ([]map[string]interface{}, error) {
 	result := make([]map[string]interface{}, 0)
 
 	for _, ig := range igs {
 		attrs := make(map[string]interface{})
+		autoscalingPolicy, err := flattenEmrAutoScalingPolicyDescription(ig.AutoScalingPolicy)
+		if err != nil {
+			return nil, err
+		}
+		attrs["autoscaling_policy"] = autoscalingPolicy
 		if ig.BidPrice != nil {
 			attrs["bid_price"] = *ig.BidPrice
 		} else {
@@ -728,7 +767,41 @@ func flattenInstanceGroups(igs []*emr.InstanceGroup) []map[string]interface{} {
 		result = append(result, attrs)
 	}
 
-	return result
+	return result, nil
+}
+
+// Encodes an emr.AutoScalingPolicyDescription into a JSON string, leaving out
+// the status which EMR manages
+func flattenEmrAutoScalingPolicyDescription(policy *emr.AutoScalingPolicyDescription) (string, error) {
+	if policy == nil {
+		return "", nil
+	}
+
+	// EMR substitutes the real cluster ID for the documented
+	// ${emr.clusterId} placeholder, so put the placeholder back to
+	// avoid a perpetual diff against the configured policy.
+	for _, rule := range policy.Rules {
+		if rule.Trigger == nil || rule.Trigger.CloudWatchAlarmDefinition == nil {
+			continue
+		}
+		for _, dimension := range rule.Trigger.CloudWatchAlarmDefinition.Dimensions {
+			if aws.StringValue(dimension.Key) == "JobFlowId" {
+				dimension.Value = aws.String("${emr.clusterId}")
+			}
+		}
+	}
+
+	autoscalingPolicy := &emr.AutoScalingPolicy{
+		Constraints: policy.Constraints,
+		Rules:       policy.Rules,
+	}
+
+	b, err := jsonutil.BuildJSON(autoscalingPolicy)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
 }
 
 func flattenBootstrapArguments(actions []*emr.Command) []map[string]interface{} {
@@ -870,7 +943,7 @@ func expandBootstrapActions(bootstrapActions []interface{}) []*emr.BootstrapActi
 	return actionsOut
 }
 
-func expandInstanceGroupConfigs(instanceGroupConfigs []interface{}) []*emr.InstanceGroupConfig {
+func expandInstanceGroupConfigs(instanceGroupConfigs []interface{}) ([]*emr.InstanceGroupConfig, error) {
 	configsOut := []*emr.InstanceGroupConfig{}
 
 	for _, raw := range instanceGroupConfigs {
@@ -918,10 +991,72 @@ func expandInstanceGroupConfigs(instanceGroupConfigs []interface{}) []*emr.Insta
 			config.EbsConfiguration = ebsConfig
 		}
 
+		if v, ok := configAttributes["autoscaling_policy"]; ok && v.(string) != "" {
+			autoScalingPolicy, err := expandEmrAutoScalingPolicy(v.(string))
+			if err != nil {
+				return nil, err
+			}
+			config.AutoScalingPolicy = autoScalingPolicy
+		}
+
 		configsOut = append(configsOut, config)
 	}
 
-	return configsOut
+	return configsOut, nil
+}
+
+func expandEmrAutoScalingPolicy(rawDefinitions string) (*emr.AutoScalingPolicy, error) {
+	var policy *emr.AutoScalingPolicy
+
+	err := json.Unmarshal([]byte(rawDefinitions), &policy)
+	if err != nil {
+		return nil, fmt.Errorf("Error decoding JSON: %s", err)
+	}
+
+	return policy, nil
+}
+
+func resourceAwsEMRClusterInstanceGroupHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+
+	buf.WriteString(fmt.Sprintf("%s-", m["instance_role"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["instance_type"].(string)))
+	buf.WriteString(fmt.Sprintf("%d-", m["instance_count"].(int)))
+
+	if v, ok := m["name"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+
+	if v, ok := m["bid_price"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+
+	// Equivalent policy documents must hash the same
+	if v, ok := m["autoscaling_policy"]; ok && v.(string) != "" {
+		policy, err := normalizeJsonString(v.(string))
+		if err != nil {
+			policy = v.(string)
+		}
+		buf.WriteString(fmt.Sprintf("%s-", policy))
+	}
+
+	if v, ok := m["ebs_config"]; ok {
+		configs := v.(*schema.Set).List()
+		for _, raw := range configs {
+			config := raw.(map[string]interface{})
+			buf.WriteString(fmt.Sprintf("%d-", config["size"].(int)))
+			buf.WriteString(fmt.Sprintf("%s-", config["type"].(string)))
+			if v, ok := config["iops"]; ok {
+				buf.WriteString(fmt.Sprintf("%d-", v.(int)))
+			}
+			if v, ok := config["volumes_per_instance"]; ok {
+				buf.WriteString(fmt.Sprintf("%d-", v.(int)))
+			}
+		}
+	}
+
+	return hashcode.String(buf.String())
 }
 
 func expandConfigures(input string) []*emr.Configuration {
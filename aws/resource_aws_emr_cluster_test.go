@@ -44,6 +44,8 @@ func TestAccAWSEMRCluster_instance_group(t *testing.T) {
 					testAccCheckAWSEmrClusterExists("aws_emr_cluster.tf-test-cluster", &cluster),
 					resource.TestCheckResourceAttr(
 						"aws_emr_cluster.tf-test-cluster", "instance_group.#", "2"),
+					resource.TestCheckResourceAttr(
+						"aws_emr_cluster.tf-test-cluster", "scale_down_behavior", "TERMINATE_AT_TASK_COMPLETION"),
 				),
 			},
 		},
@@ -254,6 +256,65 @@ func TestAccAWSEMRCluster_root_volume_size(t *testing.T) {
 	})
 }
 
+func TestFlattenEmrAutoScalingPolicyDescription(t *testing.T) {
+	policy := &emr.AutoScalingPolicyDescription{
+		Status: &emr.AutoScalingPolicyStatus{
+			State: aws.String(emr.AutoScalingPolicyStateAttached),
+		},
+		Constraints: &emr.ScalingConstraints{
+			MinCapacity: aws.Int64(1),
+			MaxCapacity: aws.Int64(2),
+		},
+		Rules: []*emr.ScalingRule{
+			{
+				Name: aws.String("ScaleOut"),
+				Action: &emr.ScalingAction{
+					SimpleScalingPolicyConfiguration: &emr.SimpleScalingPolicyConfiguration{
+						ScalingAdjustment: aws.Int64(1),
+					},
+				},
+				Trigger: &emr.ScalingTrigger{
+					CloudWatchAlarmDefinition: &emr.CloudWatchAlarmDefinition{
+						ComparisonOperator: aws.String(emr.ComparisonOperatorLessThan),
+						Dimensions: []*emr.MetricDimension{
+							{
+								Key:   aws.String("JobFlowId"),
+								Value: aws.String("j-1A2B3C4D5E6F7"),
+							},
+						},
+						MetricName: aws.String("YARNMemoryAvailablePercentage"),
+						Period:     aws.Int64(300),
+						Threshold:  aws.Float64(15),
+					},
+				},
+			},
+		},
+	}
+
+	expected := `{"Constraints":{"MaxCapacity":2,"MinCapacity":1},"Rules":[{"Action":{"SimpleScalingPolicyConfiguration":{"ScalingAdjustment":1}},"Name":"ScaleOut","Trigger":{"CloudWatchAlarmDefinition":{"ComparisonOperator":"LESS_THAN","Dimensions":[{"Key":"JobFlowId","Value":"${emr.clusterId}"}],"MetricName":"YARNMemoryAvailablePercentage","Period":300,"Threshold":15}}}]}`
+
+	result, err := flattenEmrAutoScalingPolicyDescription(policy)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !suppressEquivalentJsonDiffs("", result, expected, nil) {
+		t.Fatalf("expected %s, got %s", expected, result)
+	}
+
+	if _, err := expandEmrAutoScalingPolicy(result); err != nil {
+		t.Fatalf("unexpected error expanding flattened policy: %s", err)
+	}
+
+	result, err = flattenEmrAutoScalingPolicyDescription(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if result != "" {
+		t.Fatalf("expected empty policy, got %s", result)
+	}
+}
+
 func testAccCheck_bootstrap_order(cluster *emr.Cluster, argsInts, argsStrings []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -1346,6 +1407,45 @@ resource "aws_emr_cluster" "tf-test-cluster" {
         volumes_per_instance = 1
       }
       bid_price = "0.30"
+      autoscaling_policy = <<EOT
+{
+  "Constraints": {
+    "MinCapacity": 1,
+    "MaxCapacity": 2
+  },
+  "Rules": [
+    {
+      "Name": "ScaleOutMemoryPercentage",
+      "Description": "Scale out if YARNMemoryAvailablePercentage is less than 15",
+      "Action": {
+        "SimpleScalingPolicyConfiguration": {
+          "AdjustmentType": "CHANGE_IN_CAPACITY",
+          "ScalingAdjustment": 1,
+          "CoolDown": 300
+        }
+      },
+      "Trigger": {
+        "CloudWatchAlarmDefinition": {
+          "ComparisonOperator": "LESS_THAN",
+          "EvaluationPeriods": 1,
+          "Dimensions": [
+            {
+              "Key": "JobFlowId",
+              "Value": "$${emr.clusterId}"
+            }
+          ],
+          "MetricName": "YARNMemoryAvailablePercentage",
+          "Namespace": "AWS/ElasticMapReduce",
+          "Period": 300,
+          "Statistic": "AVERAGE",
+          "Threshold": 15.0,
+          "Unit": "PERCENT"
+        }
+      }
+    }
+  ]
+}
+EOT
     },
     {
       instance_role = "MASTER"
@@ -1376,6 +1476,7 @@ resource "aws_emr_cluster" "tf-test-cluster" {
 
   service_role = "${aws_iam_role.iam_emr_default_role.arn}"
   autoscaling_role = "${aws_iam_role.emr-autoscaling-role.arn}"
+  scale_down_behavior = "TERMINATE_AT_TASK_COMPLETION"
 }
 
 resource "aws_security_group" "allow_all" {
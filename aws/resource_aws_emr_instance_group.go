@@ -37,6 +37,12 @@ func resourceAwsEMRInstanceGroup() *schema.Resource {
 				Optional: true,
 				Default:  0,
 			},
+			"autoscaling_policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				ValidateFunc:     validateJsonString,
+			},
 			"running_instance_count": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -126,17 +132,25 @@ func resourceAwsEMRInstanceGroupCreate(d *schema.ResourceData, meta interface{})
 
 	ebsConfig := readEmrEBSConfig(d)
 
+	groupConfig := &emr.InstanceGroupConfig{
+		InstanceRole:     aws.String("TASK"),
+		InstanceCount:    aws.Int64(int64(instanceCount)),
+		InstanceType:     aws.String(instanceType),
+		Name:             aws.String(groupName),
+		EbsConfiguration: ebsConfig,
+	}
+
+	if v, ok := d.GetOk("autoscaling_policy"); ok {
+		autoScalingPolicy, err := expandEmrAutoScalingPolicy(v.(string))
+		if err != nil {
+			return err
+		}
+		groupConfig.AutoScalingPolicy = autoScalingPolicy
+	}
+
 	params := &emr.AddInstanceGroupsInput{
-		InstanceGroups: []*emr.InstanceGroupConfig{
-			{
-				InstanceRole:     aws.String("TASK"),
-				InstanceCount:    aws.Int64(int64(instanceCount)),
-				InstanceType:     aws.String(instanceType),
-				Name:             aws.String(groupName),
-				EbsConfiguration: ebsConfig,
-			},
-		},
-		JobFlowId: aws.String(clusterId),
+		InstanceGroups: []*emr.InstanceGroupConfig{groupConfig},
+		JobFlowId:      aws.String(clusterId),
 	}
 
 	log.Printf("[DEBUG] Creating EMR task group params: %s", params)
@@ -184,6 +198,12 @@ func resourceAwsEMRInstanceGroupRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("status", group.Status.State)
 	}
 
+	autoscalingPolicy, err := flattenEmrAutoScalingPolicyDescription(group.AutoScalingPolicy)
+	if err != nil {
+		return err
+	}
+	d.Set("autoscaling_policy", autoscalingPolicy)
+
 	return nil
 }
 
@@ -246,35 +266,67 @@ func resourceAwsEMRInstanceGroupUpdate(d *schema.ResourceData, meta interface{})
 	conn := meta.(*AWSClient).emrconn
 
 	log.Printf("[DEBUG] Modify EMR task group")
-	instanceCount := d.Get("instance_count").(int)
-
-	params := &emr.ModifyInstanceGroupsInput{
-		InstanceGroups: []*emr.InstanceGroupModifyConfig{
-			{
-				InstanceGroupId: aws.String(d.Id()),
-				InstanceCount:   aws.Int64(int64(instanceCount)),
+	if d.HasChange("instance_count") {
+		instanceCount := d.Get("instance_count").(int)
+
+		params := &emr.ModifyInstanceGroupsInput{
+			InstanceGroups: []*emr.InstanceGroupModifyConfig{
+				{
+					InstanceGroupId: aws.String(d.Id()),
+					InstanceCount:   aws.Int64(int64(instanceCount)),
+				},
 			},
-		},
-	}
+		}
 
-	_, err := conn.ModifyInstanceGroups(params)
-	if err != nil {
-		return err
-	}
+		_, err := conn.ModifyInstanceGroups(params)
+		if err != nil {
+			return err
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"PROVISIONING", "BOOTSTRAPPING", "RESIZING"},
+			Target:     []string{"RUNNING"},
+			Refresh:    instanceGroupStateRefresh(conn, d.Get("cluster_id").(string), d.Id()),
+			Timeout:    10 * time.Minute,
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"PROVISIONING", "BOOTSTRAPPING", "RESIZING"},
-		Target:     []string{"RUNNING"},
-		Refresh:    instanceGroupStateRefresh(conn, d.Get("cluster_id").(string), d.Id()),
-		Timeout:    10 * time.Minute,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf(
+				"Error waiting for instance (%s) to terminate: %s", d.Id(), err)
+		}
 	}
 
-	_, err = stateConf.WaitForState()
-	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for instance (%s) to terminate: %s", d.Id(), err)
+	if d.HasChange("autoscaling_policy") {
+		clusterId := d.Get("cluster_id").(string)
+
+		if v := d.Get("autoscaling_policy").(string); v != "" {
+			autoScalingPolicy, err := expandEmrAutoScalingPolicy(v)
+			if err != nil {
+				return err
+			}
+
+			log.Printf("[DEBUG] Putting autoscaling policy on EMR task group (%s)", d.Id())
+			_, err = conn.PutAutoScalingPolicy(&emr.PutAutoScalingPolicyInput{
+				ClusterId:         aws.String(clusterId),
+				InstanceGroupId:   aws.String(d.Id()),
+				AutoScalingPolicy: autoScalingPolicy,
+			})
+			if err != nil {
+				return fmt.Errorf("Error putting autoscaling policy on EMR task group (%s): %s", d.Id(), err)
+			}
+		} else {
+			log.Printf("[DEBUG] Removing autoscaling policy from EMR task group (%s)", d.Id())
+			_, err := conn.RemoveAutoScalingPolicy(&emr.RemoveAutoScalingPolicyInput{
+				ClusterId:       aws.String(clusterId),
+				InstanceGroupId: aws.String(d.Id()),
+			})
+			if err != nil {
+				return fmt.Errorf("Error removing autoscaling policy from EMR task group (%s): %s", d.Id(), err)
+			}
+		}
 	}
 
 	return resourceAwsEMRInstanceGroupRead(d, meta)
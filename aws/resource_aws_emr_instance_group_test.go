@@ -72,6 +72,39 @@ func TestAccAWSEMRInstanceGroup_ebsBasic(t *testing.T) {
 	})
 }
 
+func TestAccAWSEMRInstanceGroup_autoscalingPolicy(t *testing.T) {
+	var ig emr.InstanceGroup
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEmrInstanceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEmrInstanceGroupConfig_autoscalingPolicy(rInt, 1, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEmrInstanceGroupExists("aws_emr_instance_group.task", &ig),
+					resource.TestCheckResourceAttrSet("aws_emr_instance_group.task", "autoscaling_policy"),
+				),
+			},
+			{
+				Config: testAccAWSEmrInstanceGroupConfig_autoscalingPolicy(rInt, 2, 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEmrInstanceGroupExists("aws_emr_instance_group.task", &ig),
+					resource.TestCheckResourceAttrSet("aws_emr_instance_group.task", "autoscaling_policy"),
+				),
+			},
+			{
+				Config: testAccAWSEmrInstanceGroupConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEmrInstanceGroupExists("aws_emr_instance_group.task", &ig),
+					resource.TestCheckResourceAttr("aws_emr_instance_group.task", "autoscaling_policy", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSEmrInstanceGroupDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).emrconn
 
@@ -165,6 +198,7 @@ resource "aws_emr_cluster" "tf-test-cluster" {
 
   configurations = "test-fixtures/emr_configurations.json"
   service_role = "${aws_iam_role.iam_emr_default_role.arn}"
+  autoscaling_role = "${aws_iam_role.emr-autoscaling-role.arn}"
 
   depends_on = ["aws_internet_gateway.gw"]
 }
@@ -394,6 +428,29 @@ resource "aws_iam_policy" "iam_emr_profile_policy" {
 }
 EOT
 }
+
+# IAM Role for autoscaling
+resource "aws_iam_role" "emr-autoscaling-role" {
+  name               = "EMR_AutoScaling_DefaultRole_%d"
+  assume_role_policy = "${data.aws_iam_policy_document.emr-autoscaling-role-policy.json}"
+}
+
+data "aws_iam_policy_document" "emr-autoscaling-role-policy" {
+  statement {
+    effect  = "Allow"
+    actions = ["sts:AssumeRole"]
+
+    principals = {
+      type        = "Service"
+      identifiers = ["elasticmapreduce.amazonaws.com", "application-autoscaling.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_iam_role_policy_attachment" "emr-autoscaling-role" {
+  role       = "${aws_iam_role.emr-autoscaling-role.name}"
+  policy_arn = "arn:aws:iam::aws:policy/service-role/AmazonElasticMapReduceforAutoScalingRole"
+}
 `
 
 func testAccAWSEmrInstanceGroupConfig(r int) string {
@@ -403,7 +460,7 @@ func testAccAWSEmrInstanceGroupConfig(r int) string {
     instance_count = 1
     instance_type  = "c4.large"
   }
-	`, r, r, r, r, r, r)
+	`, r, r, r, r, r, r, r)
 }
 
 func testAccAWSEmrInstanceGroupConfig_zero_count(r int) string {
@@ -413,7 +470,7 @@ func testAccAWSEmrInstanceGroupConfig_zero_count(r int) string {
     instance_count = 0
     instance_type  = "c4.large"
   }
-	`, r, r, r, r, r, r)
+	`, r, r, r, r, r, r, r)
 }
 
 func testAccAWSEmrInstanceGroupConfig_ebsBasic(r int) string {
@@ -428,5 +485,55 @@ func testAccAWSEmrInstanceGroupConfig_ebsBasic(r int) string {
       "type" = "gp2",
     }
   }
-	`, r, r, r, r, r, r)
+	`, r, r, r, r, r, r, r)
+}
+
+func testAccAWSEmrInstanceGroupConfig_autoscalingPolicy(r, min, max int) string {
+	return fmt.Sprintf(testAccAWSEmrInstanceGroupBase+`
+resource "aws_emr_instance_group" "task" {
+  cluster_id     = "${aws_emr_cluster.tf-test-cluster.id}"
+  instance_count = 1
+  instance_type  = "c4.large"
+
+  autoscaling_policy = <<EOT
+{
+  "Constraints": {
+    "MinCapacity": %d,
+    "MaxCapacity": %d
+  },
+  "Rules": [
+    {
+      "Name": "ScaleOutMemoryPercentage",
+      "Description": "Scale out if YARNMemoryAvailablePercentage is less than 15",
+      "Action": {
+        "SimpleScalingPolicyConfiguration": {
+          "AdjustmentType": "CHANGE_IN_CAPACITY",
+          "ScalingAdjustment": 1,
+          "CoolDown": 300
+        }
+      },
+      "Trigger": {
+        "CloudWatchAlarmDefinition": {
+          "ComparisonOperator": "LESS_THAN",
+          "EvaluationPeriods": 1,
+          "Dimensions": [
+            {
+              "Key": "JobFlowId",
+              "Value": "$${emr.clusterId}"
+            }
+          ],
+          "MetricName": "YARNMemoryAvailablePercentage",
+          "Namespace": "AWS/ElasticMapReduce",
+          "Period": 300,
+          "Statistic": "AVERAGE",
+          "Threshold": 15.0,
+          "Unit": "PERCENT"
+        }
+      }
+    }
+  ]
+}
+EOT
+}
+`, r, r, r, r, r, r, r, min, max)
 }
@@ -0,0 +1,150 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsGuardDutyInviteAccepter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGuardDutyInviteAccepterCreate,
+		Read:   resourceAwsGuardDutyInviteAccepterRead,
+		Delete: resourceAwsGuardDutyInviteAccepterDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"detector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"master_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+		},
+	}
+}
+
+func resourceAwsGuardDutyInviteAccepterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	detectorID := d.Get("detector_id").(string)
+	masterAccountID := d.Get("master_account_id").(string)
+
+	// The invitation may take a moment to reach the member account
+	var invitationID string
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		var err error
+		invitationID, err = findGuardDutyInvitationID(conn, masterAccountID)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if invitationID == "" {
+			return resource.RetryableError(fmt.Errorf("GuardDuty invitation from master account %s not found", masterAccountID))
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error finding GuardDuty invitation: %s", err)
+	}
+
+	input := &guardduty.AcceptInvitationInput{
+		DetectorId:   aws.String(detectorID),
+		InvitationId: aws.String(invitationID),
+		MasterId:     aws.String(masterAccountID),
+	}
+
+	log.Printf("[DEBUG] Accepting GuardDuty invitation: %s", input)
+	_, err = conn.AcceptInvitation(input)
+	if err != nil {
+		return fmt.Errorf("Accepting GuardDuty invitation failed: %s", err)
+	}
+
+	d.SetId(detectorID)
+
+	return resourceAwsGuardDutyInviteAccepterRead(d, meta)
+}
+
+func resourceAwsGuardDutyInviteAccepterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	input := &guardduty.GetMasterAccountInput{
+		DetectorId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading GuardDuty master account: %s", input)
+	resp, err := conn.GetMasterAccount(input)
+	if err != nil {
+		if isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.") {
+			log.Printf("[WARN] GuardDuty detector %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Reading GuardDuty master account for detector '%s' failed: %s", d.Id(), err)
+	}
+
+	if resp.Master == nil {
+		log.Printf("[WARN] GuardDuty detector %q has no master account, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("detector_id", d.Id())
+	d.Set("master_account_id", resp.Master.AccountId)
+
+	return nil
+}
+
+func resourceAwsGuardDutyInviteAccepterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	input := &guardduty.DisassociateFromMasterAccountInput{
+		DetectorId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Disassociating GuardDuty detector from master account: %s", input)
+	_, err := conn.DisassociateFromMasterAccount(input)
+	if err != nil {
+		if isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.") {
+			return nil
+		}
+		return fmt.Errorf("Disassociating GuardDuty detector '%s' from master account failed: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// findGuardDutyInvitationID returns the ID of the pending invitation from
+// the given master account, or an empty string if there is none.
+func findGuardDutyInvitationID(conn *guardduty.GuardDuty, masterAccountID string) (string, error) {
+	var invitationID string
+
+	input := &guardduty.ListInvitationsInput{}
+	err := conn.ListInvitationsPages(input, func(page *guardduty.ListInvitationsOutput, lastPage bool) bool {
+		for _, invitation := range page.Invitations {
+			if aws.StringValue(invitation.AccountId) == masterAccountID {
+				invitationID = aws.StringValue(invitation.InvitationId)
+				return false
+			}
+		}
+		return !lastPage
+	})
+
+	return invitationID, err
+}
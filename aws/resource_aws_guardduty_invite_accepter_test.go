@@ -0,0 +1,102 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccAwsGuardDutyInviteAccepter_basic(t *testing.T) {
+	masterAccountID := os.Getenv("GUARDDUTY_MASTER_ACCOUNT_ID")
+	if masterAccountID == "" {
+		t.Skip("Environment variable GUARDDUTY_MASTER_ACCOUNT_ID is not set")
+	}
+
+	resourceName := "aws_guardduty_invite_accepter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsGuardDutyInviteAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardDutyInviteAccepterConfig(masterAccountID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsGuardDutyInviteAccepterExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "detector_id", "aws_guardduty_detector.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "master_account_id", masterAccountID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsGuardDutyInviteAccepterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_guardduty_invite_accepter" {
+			continue
+		}
+
+		resp, err := conn.GetMasterAccount(&guardduty.GetMasterAccountInput{
+			DetectorId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.") {
+				return nil
+			}
+			return err
+		}
+
+		if resp.Master != nil && aws.StringValue(resp.Master.RelationshipStatus) == "Enabled" {
+			return fmt.Errorf("Expected GuardDuty detector %s to be disassociated from its master account", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsGuardDutyInviteAccepterExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+		resp, err := conn.GetMasterAccount(&guardduty.GetMasterAccountInput{
+			DetectorId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if resp.Master == nil {
+			return fmt.Errorf("GuardDuty detector %s has no master account", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccGuardDutyInviteAccepterConfig(masterAccountID string) string {
+	return fmt.Sprintf(`
+resource "aws_guardduty_detector" "test" {}
+
+resource "aws_guardduty_invite_accepter" "test" {
+  detector_id       = "${aws_guardduty_detector.test.id}"
+  master_account_id = "%s"
+}
+`, masterAccountID)
+}
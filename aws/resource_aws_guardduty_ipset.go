@@ -0,0 +1,254 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsGuardDutyIpset() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGuardDutyIpsetCreate,
+		Read:   resourceAwsGuardDutyIpsetRead,
+		Update: resourceAwsGuardDutyIpsetUpdate,
+		Delete: resourceAwsGuardDutyIpsetDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"detector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"format": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					guardduty.IpSetFormatTxt,
+					guardduty.IpSetFormatStix,
+					guardduty.IpSetFormatOtxCsv,
+					guardduty.IpSetFormatAlienVault,
+					guardduty.IpSetFormatProofPoint,
+					guardduty.IpSetFormatFireEye,
+				}, false),
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"activate": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceAwsGuardDutyIpsetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	detectorID := d.Get("detector_id").(string)
+	input := &guardduty.CreateIPSetInput{
+		DetectorId: aws.String(detectorID),
+		Name:       aws.String(d.Get("name").(string)),
+		Format:     aws.String(d.Get("format").(string)),
+		Location:   aws.String(d.Get("location").(string)),
+		Activate:   aws.Bool(d.Get("activate").(bool)),
+	}
+
+	log.Printf("[DEBUG] Creating GuardDuty IPSet: %s", input)
+	resp, err := conn.CreateIPSet(input)
+	if err != nil {
+		return fmt.Errorf("Creating GuardDuty IPSet failed: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", detectorID, aws.StringValue(resp.IpSetId)))
+
+	if err := waitForGuardDutyIpsetStatus(conn, detectorID, aws.StringValue(resp.IpSetId), d.Get("activate").(bool)); err != nil {
+		return fmt.Errorf("Error waiting for GuardDuty IPSet (%s) status: %s", d.Id(), err)
+	}
+
+	return resourceAwsGuardDutyIpsetRead(d, meta)
+}
+
+func resourceAwsGuardDutyIpsetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	detectorID, ipSetID, err := decodeGuardDutyIpsetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &guardduty.GetIPSetInput{
+		DetectorId: aws.String(detectorID),
+		IpSetId:    aws.String(ipSetID),
+	}
+
+	log.Printf("[DEBUG] Reading GuardDuty IPSet: %s", input)
+	resp, err := conn.GetIPSet(input)
+	if err != nil {
+		if isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.") {
+			log.Printf("[WARN] GuardDuty IPSet %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Reading GuardDuty IPSet '%s' failed: %s", d.Id(), err)
+	}
+
+	if aws.StringValue(resp.Status) == guardduty.IpSetStatusDeletePending || aws.StringValue(resp.Status) == guardduty.IpSetStatusDeleted {
+		log.Printf("[WARN] GuardDuty IPSet %q is deleted, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("detector_id", detectorID)
+	d.Set("format", resp.Format)
+	d.Set("location", resp.Location)
+	d.Set("name", resp.Name)
+	d.Set("activate", aws.StringValue(resp.Status) == guardduty.IpSetStatusActive)
+
+	return nil
+}
+
+func resourceAwsGuardDutyIpsetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	detectorID, ipSetID, err := decodeGuardDutyIpsetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &guardduty.UpdateIPSetInput{
+		DetectorId: aws.String(detectorID),
+		IpSetId:    aws.String(ipSetID),
+	}
+	if d.HasChange("name") {
+		input.Name = aws.String(d.Get("name").(string))
+	}
+	if d.HasChange("location") {
+		input.Location = aws.String(d.Get("location").(string))
+	}
+	if d.HasChange("activate") {
+		input.Activate = aws.Bool(d.Get("activate").(bool))
+	}
+
+	log.Printf("[DEBUG] Updating GuardDuty IPSet: %s", input)
+	_, err = conn.UpdateIPSet(input)
+	if err != nil {
+		return fmt.Errorf("Updating GuardDuty IPSet '%s' failed: %s", d.Id(), err)
+	}
+
+	if d.HasChange("activate") {
+		if err := waitForGuardDutyIpsetStatus(conn, detectorID, ipSetID, d.Get("activate").(bool)); err != nil {
+			return fmt.Errorf("Error waiting for GuardDuty IPSet (%s) status: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsGuardDutyIpsetRead(d, meta)
+}
+
+func resourceAwsGuardDutyIpsetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	detectorID, ipSetID, err := decodeGuardDutyIpsetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &guardduty.DeleteIPSetInput{
+		DetectorId: aws.String(detectorID),
+		IpSetId:    aws.String(ipSetID),
+	}
+
+	log.Printf("[DEBUG] Delete GuardDuty IPSet: %s", input)
+	_, err = conn.DeleteIPSet(input)
+	if err != nil {
+		if isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.") {
+			return nil
+		}
+		return fmt.Errorf("Deleting GuardDuty IPSet '%s' failed: %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			guardduty.IpSetStatusActive,
+			guardduty.IpSetStatusActivating,
+			guardduty.IpSetStatusInactive,
+			guardduty.IpSetStatusDeactivating,
+			guardduty.IpSetStatusDeletePending,
+		},
+		Target:     []string{guardduty.IpSetStatusDeleted},
+		Refresh:    guardDutyIpsetRefreshStatusFunc(conn, detectorID, ipSetID),
+		Timeout:    5 * time.Minute,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for GuardDuty IPSet (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func waitForGuardDutyIpsetStatus(conn *guardduty.GuardDuty, detectorID, ipSetID string, activate bool) error {
+	target := guardduty.IpSetStatusInactive
+	if activate {
+		target = guardduty.IpSetStatusActive
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			guardduty.IpSetStatusActivating,
+			guardduty.IpSetStatusDeactivating,
+		},
+		Target:     []string{target},
+		Refresh:    guardDutyIpsetRefreshStatusFunc(conn, detectorID, ipSetID),
+		Timeout:    5 * time.Minute,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func guardDutyIpsetRefreshStatusFunc(conn *guardduty.GuardDuty, detectorID, ipSetID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.GetIPSet(&guardduty.GetIPSetInput{
+			DetectorId: aws.String(detectorID),
+			IpSetId:    aws.String(ipSetID),
+		})
+		if err != nil {
+			return nil, "failed", err
+		}
+
+		status := aws.StringValue(resp.Status)
+		if status == guardduty.IpSetStatusError {
+			return resp, status, fmt.Errorf("GuardDuty IPSet (%s) is in an error state", ipSetID)
+		}
+
+		return resp, status, nil
+	}
+}
+
+func decodeGuardDutyIpsetID(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("GuardDuty IPSet ID must be of the form <Detector ID>:<IPSet ID>, was provided: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,269 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsGuardDutyMember() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGuardDutyMemberCreate,
+		Read:   resourceAwsGuardDutyMemberRead,
+		Update: resourceAwsGuardDutyMemberUpdate,
+		Delete: resourceAwsGuardDutyMemberDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Second),
+			Update: schema.DefaultTimeout(60 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"detector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"invite": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"invitation_message": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"relationship_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsGuardDutyMemberCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	accountID := d.Get("account_id").(string)
+	detectorID := d.Get("detector_id").(string)
+
+	input := &guardduty.CreateMembersInput{
+		AccountDetails: []*guardduty.AccountDetail{{
+			AccountId: aws.String(accountID),
+			Email:     aws.String(d.Get("email").(string)),
+		}},
+		DetectorId: aws.String(detectorID),
+	}
+
+	log.Printf("[DEBUG] Creating GuardDuty Member: %s", input)
+	resp, err := conn.CreateMembers(input)
+	if err != nil {
+		return fmt.Errorf("Creating GuardDuty Member failed: %s", err)
+	}
+	if len(resp.UnprocessedAccounts) > 0 {
+		return fmt.Errorf("Creating GuardDuty Member failed: %s", aws.StringValue(resp.UnprocessedAccounts[0].Result))
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", detectorID, accountID))
+
+	if d.Get("invite").(bool) {
+		if err := inviteAwsGuardDutyMember(conn, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsGuardDutyMemberRead(d, meta)
+}
+
+func resourceAwsGuardDutyMemberRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	detectorID, accountID, err := decodeGuardDutyMemberID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &guardduty.GetMembersInput{
+		AccountIds: []*string{aws.String(accountID)},
+		DetectorId: aws.String(detectorID),
+	}
+
+	log.Printf("[DEBUG] Reading GuardDuty Member: %s", input)
+	resp, err := conn.GetMembers(input)
+	if err != nil {
+		if isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.") {
+			log.Printf("[WARN] GuardDuty detector %q not found, removing GuardDuty Member %q from state", detectorID, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Reading GuardDuty Member '%s' failed: %s", d.Id(), err)
+	}
+
+	if len(resp.Members) == 0 {
+		log.Printf("[WARN] GuardDuty Member %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	member := resp.Members[0]
+	d.Set("account_id", member.AccountId)
+	d.Set("detector_id", detectorID)
+	d.Set("email", member.Email)
+
+	status := aws.StringValue(member.RelationshipStatus)
+	d.Set("relationship_status", status)
+
+	// https://docs.aws.amazon.com/guardduty/latest/ug/list-members.html
+	d.Set("invite", false)
+	if status == "Disabled" || status == "Enabled" || status == "Invited" || status == "EmailVerificationInProgress" {
+		d.Set("invite", true)
+	}
+
+	return nil
+}
+
+func resourceAwsGuardDutyMemberUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	if d.HasChange("invite") {
+		if d.Get("invite").(bool) {
+			if err := inviteAwsGuardDutyMember(conn, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		} else {
+			detectorID, accountID, err := decodeGuardDutyMemberID(d.Id())
+			if err != nil {
+				return err
+			}
+
+			input := &guardduty.DisassociateMembersInput{
+				AccountIds: []*string{aws.String(accountID)},
+				DetectorId: aws.String(detectorID),
+			}
+
+			log.Printf("[DEBUG] Disassociating GuardDuty Member: %s", input)
+			resp, err := conn.DisassociateMembers(input)
+			if err != nil {
+				return fmt.Errorf("Disassociating GuardDuty Member '%s' failed: %s", d.Id(), err)
+			}
+			if len(resp.UnprocessedAccounts) > 0 {
+				return fmt.Errorf("Disassociating GuardDuty Member '%s' failed: %s", d.Id(), aws.StringValue(resp.UnprocessedAccounts[0].Result))
+			}
+		}
+	}
+
+	return resourceAwsGuardDutyMemberRead(d, meta)
+}
+
+func resourceAwsGuardDutyMemberDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	detectorID, accountID, err := decodeGuardDutyMemberID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &guardduty.DeleteMembersInput{
+		AccountIds: []*string{aws.String(accountID)},
+		DetectorId: aws.String(detectorID),
+	}
+
+	log.Printf("[DEBUG] Delete GuardDuty Member: %s", input)
+	resp, err := conn.DeleteMembers(input)
+	if err != nil {
+		if isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.") {
+			return nil
+		}
+		return fmt.Errorf("Deleting GuardDuty Member '%s' failed: %s", d.Id(), err)
+	}
+	if len(resp.UnprocessedAccounts) > 0 {
+		return fmt.Errorf("Deleting GuardDuty Member '%s' failed: %s", d.Id(), aws.StringValue(resp.UnprocessedAccounts[0].Result))
+	}
+
+	return nil
+}
+
+// inviteAwsGuardDutyMember invites the member account and waits for the
+// invitation to be sent.
+func inviteAwsGuardDutyMember(conn *guardduty.GuardDuty, d *schema.ResourceData, timeout time.Duration) error {
+	detectorID, accountID, err := decodeGuardDutyMemberID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &guardduty.InviteMembersInput{
+		AccountIds: []*string{aws.String(accountID)},
+		DetectorId: aws.String(detectorID),
+	}
+	if v, ok := d.GetOk("invitation_message"); ok {
+		input.Message = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Inviting GuardDuty Member: %s", input)
+	resp, err := conn.InviteMembers(input)
+	if err != nil {
+		return fmt.Errorf("Inviting GuardDuty Member '%s' failed: %s", d.Id(), err)
+	}
+	if len(resp.UnprocessedAccounts) > 0 {
+		return fmt.Errorf("Inviting GuardDuty Member '%s' failed: %s", d.Id(), aws.StringValue(resp.UnprocessedAccounts[0].Result))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Created", "EmailVerificationInProgress"},
+		Target:  []string{"Invited", "Enabled"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := conn.GetMembers(&guardduty.GetMembersInput{
+				AccountIds: []*string{aws.String(accountID)},
+				DetectorId: aws.String(detectorID),
+			})
+			if err != nil {
+				return nil, "failed", err
+			}
+			if len(resp.Members) == 0 {
+				return nil, "failed", fmt.Errorf("GuardDuty Member '%s' not found", d.Id())
+			}
+
+			status := aws.StringValue(resp.Members[0].RelationshipStatus)
+			if status == "EmailVerificationFailed" {
+				return resp, status, fmt.Errorf("GuardDuty Member '%s' invitation email verification failed", d.Id())
+			}
+			return resp, status, nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for GuardDuty Member '%s' invitation: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeGuardDutyMemberID(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("GuardDuty Member ID must be of the form <Detector ID>:<Member AWS Account ID>, was provided: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
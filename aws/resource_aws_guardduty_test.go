@@ -10,6 +10,21 @@ func TestAccAWSGuardDuty(t *testing.T) {
 			"basic":  testAccAwsGuardDutyDetector_basic,
 			"import": testAccAwsGuardDutyDetector_import,
 		},
+		"InviteAccepter": {
+			"basic": testAccAwsGuardDutyInviteAccepter_basic,
+		},
+		"IPSet": {
+			"basic":  testAccAwsGuardDutyIpset_basic,
+			"import": testAccAwsGuardDutyIpset_import,
+		},
+		"Member": {
+			"basic":  testAccAwsGuardDutyMember_basic,
+			"invite": testAccAwsGuardDutyMember_invite,
+		},
+		"ThreatIntelSet": {
+			"basic":  testAccAwsGuardDutyThreatintelset_basic,
+			"import": testAccAwsGuardDutyThreatintelset_import,
+		},
 	}
 
 	for group, m := range testCases {
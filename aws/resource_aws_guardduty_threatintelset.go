@@ -0,0 +1,254 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsGuardDutyThreatintelset() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGuardDutyThreatintelsetCreate,
+		Read:   resourceAwsGuardDutyThreatintelsetRead,
+		Update: resourceAwsGuardDutyThreatintelsetUpdate,
+		Delete: resourceAwsGuardDutyThreatintelsetDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"detector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"format": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					guardduty.ThreatIntelSetFormatTxt,
+					guardduty.ThreatIntelSetFormatStix,
+					guardduty.ThreatIntelSetFormatOtxCsv,
+					guardduty.ThreatIntelSetFormatAlienVault,
+					guardduty.ThreatIntelSetFormatProofPoint,
+					guardduty.ThreatIntelSetFormatFireEye,
+				}, false),
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"activate": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceAwsGuardDutyThreatintelsetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	detectorID := d.Get("detector_id").(string)
+	input := &guardduty.CreateThreatIntelSetInput{
+		DetectorId: aws.String(detectorID),
+		Name:       aws.String(d.Get("name").(string)),
+		Format:     aws.String(d.Get("format").(string)),
+		Location:   aws.String(d.Get("location").(string)),
+		Activate:   aws.Bool(d.Get("activate").(bool)),
+	}
+
+	log.Printf("[DEBUG] Creating GuardDuty ThreatIntelSet: %s", input)
+	resp, err := conn.CreateThreatIntelSet(input)
+	if err != nil {
+		return fmt.Errorf("Creating GuardDuty ThreatIntelSet failed: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", detectorID, aws.StringValue(resp.ThreatIntelSetId)))
+
+	if err := waitForGuardDutyThreatintelsetStatus(conn, detectorID, aws.StringValue(resp.ThreatIntelSetId), d.Get("activate").(bool)); err != nil {
+		return fmt.Errorf("Error waiting for GuardDuty ThreatIntelSet (%s) status: %s", d.Id(), err)
+	}
+
+	return resourceAwsGuardDutyThreatintelsetRead(d, meta)
+}
+
+func resourceAwsGuardDutyThreatintelsetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	detectorID, threatIntelSetID, err := decodeGuardDutyThreatintelsetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &guardduty.GetThreatIntelSetInput{
+		DetectorId:       aws.String(detectorID),
+		ThreatIntelSetId: aws.String(threatIntelSetID),
+	}
+
+	log.Printf("[DEBUG] Reading GuardDuty ThreatIntelSet: %s", input)
+	resp, err := conn.GetThreatIntelSet(input)
+	if err != nil {
+		if isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.") {
+			log.Printf("[WARN] GuardDuty ThreatIntelSet %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Reading GuardDuty ThreatIntelSet '%s' failed: %s", d.Id(), err)
+	}
+
+	if aws.StringValue(resp.Status) == guardduty.ThreatIntelSetStatusDeletePending || aws.StringValue(resp.Status) == guardduty.ThreatIntelSetStatusDeleted {
+		log.Printf("[WARN] GuardDuty ThreatIntelSet %q is deleted, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("detector_id", detectorID)
+	d.Set("format", resp.Format)
+	d.Set("location", resp.Location)
+	d.Set("name", resp.Name)
+	d.Set("activate", aws.StringValue(resp.Status) == guardduty.ThreatIntelSetStatusActive)
+
+	return nil
+}
+
+func resourceAwsGuardDutyThreatintelsetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	detectorID, threatIntelSetID, err := decodeGuardDutyThreatintelsetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &guardduty.UpdateThreatIntelSetInput{
+		DetectorId:       aws.String(detectorID),
+		ThreatIntelSetId: aws.String(threatIntelSetID),
+	}
+	if d.HasChange("name") {
+		input.Name = aws.String(d.Get("name").(string))
+	}
+	if d.HasChange("location") {
+		input.Location = aws.String(d.Get("location").(string))
+	}
+	if d.HasChange("activate") {
+		input.Activate = aws.Bool(d.Get("activate").(bool))
+	}
+
+	log.Printf("[DEBUG] Updating GuardDuty ThreatIntelSet: %s", input)
+	_, err = conn.UpdateThreatIntelSet(input)
+	if err != nil {
+		return fmt.Errorf("Updating GuardDuty ThreatIntelSet '%s' failed: %s", d.Id(), err)
+	}
+
+	if d.HasChange("activate") {
+		if err := waitForGuardDutyThreatintelsetStatus(conn, detectorID, threatIntelSetID, d.Get("activate").(bool)); err != nil {
+			return fmt.Errorf("Error waiting for GuardDuty ThreatIntelSet (%s) status: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsGuardDutyThreatintelsetRead(d, meta)
+}
+
+func resourceAwsGuardDutyThreatintelsetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	detectorID, threatIntelSetID, err := decodeGuardDutyThreatintelsetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &guardduty.DeleteThreatIntelSetInput{
+		DetectorId:       aws.String(detectorID),
+		ThreatIntelSetId: aws.String(threatIntelSetID),
+	}
+
+	log.Printf("[DEBUG] Delete GuardDuty ThreatIntelSet: %s", input)
+	_, err = conn.DeleteThreatIntelSet(input)
+	if err != nil {
+		if isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.") {
+			return nil
+		}
+		return fmt.Errorf("Deleting GuardDuty ThreatIntelSet '%s' failed: %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			guardduty.ThreatIntelSetStatusActive,
+			guardduty.ThreatIntelSetStatusActivating,
+			guardduty.ThreatIntelSetStatusInactive,
+			guardduty.ThreatIntelSetStatusDeactivating,
+			guardduty.ThreatIntelSetStatusDeletePending,
+		},
+		Target:     []string{guardduty.ThreatIntelSetStatusDeleted},
+		Refresh:    guardDutyThreatintelsetRefreshStatusFunc(conn, detectorID, threatIntelSetID),
+		Timeout:    5 * time.Minute,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for GuardDuty ThreatIntelSet (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func waitForGuardDutyThreatintelsetStatus(conn *guardduty.GuardDuty, detectorID, threatIntelSetID string, activate bool) error {
+	target := guardduty.ThreatIntelSetStatusInactive
+	if activate {
+		target = guardduty.ThreatIntelSetStatusActive
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			guardduty.ThreatIntelSetStatusActivating,
+			guardduty.ThreatIntelSetStatusDeactivating,
+		},
+		Target:     []string{target},
+		Refresh:    guardDutyThreatintelsetRefreshStatusFunc(conn, detectorID, threatIntelSetID),
+		Timeout:    5 * time.Minute,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func guardDutyThreatintelsetRefreshStatusFunc(conn *guardduty.GuardDuty, detectorID, threatIntelSetID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.GetThreatIntelSet(&guardduty.GetThreatIntelSetInput{
+			DetectorId:       aws.String(detectorID),
+			ThreatIntelSetId: aws.String(threatIntelSetID),
+		})
+		if err != nil {
+			return nil, "failed", err
+		}
+
+		status := aws.StringValue(resp.Status)
+		if status == guardduty.ThreatIntelSetStatusError {
+			return resp, status, fmt.Errorf("GuardDuty ThreatIntelSet (%s) is in an error state", threatIntelSetID)
+		}
+
+		return resp, status, nil
+	}
+}
+
+func decodeGuardDutyThreatintelsetID(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("GuardDuty ThreatIntelSet ID must be of the form <Detector ID>:<ThreatIntelSet ID>, was provided: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
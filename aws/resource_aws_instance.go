@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsInstance() *schema.Resource {
@@ -265,6 +266,36 @@ func resourceAwsInstance() *schema.Resource {
 
 			"volume_tags": tagsSchemaComputed(),
 
+			"credit_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// Removing the block from configuration leaves the
+					// existing credit option in place, so don't show a diff.
+					if d.Id() == "" {
+						return false
+					}
+					if new != "" && new != "0" {
+						return false
+					}
+					return old != "" && old != "0"
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cpu_credits": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "standard",
+							ValidateFunc: validation.StringInSlice([]string{
+								"standard",
+								"unlimited",
+							}, false),
+						},
+					},
+				},
+			},
+
 			"block_device": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -445,6 +476,7 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	// Build the creation struct
 	runOpts := &ec2.RunInstancesInput{
 		BlockDeviceMappings:   instanceOpts.BlockDeviceMappings,
+		CreditSpecification:   instanceOpts.CreditSpecification,
 		DisableApiTermination: instanceOpts.DisableAPITermination,
 		EbsOptimized:          instanceOpts.EBSOptimized,
 		Monitoring:            instanceOpts.Monitoring,
@@ -773,6 +805,17 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// Credit specifications are only available for burstable instances
+	if strings.HasPrefix(aws.StringValue(instance.InstanceType), "t2.") {
+		creditSpecifications, err := getInstanceCreditSpecifications(conn, d.Id())
+		if err != nil {
+			return err
+		}
+		if err := d.Set("credit_specification", creditSpecifications); err != nil {
+			return fmt.Errorf("error setting credit_specification: %s", err)
+		}
+	}
+
 	return nil
 }
 
@@ -1035,6 +1078,30 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("credit_specification") && !d.IsNewResource() {
+		if v, ok := d.GetOk("credit_specification"); ok {
+			creditSpecification := v.([]interface{})[0].(map[string]interface{})
+			log.Printf("[DEBUG] Modifying credit specification for Instance (%s)", d.Id())
+			resp, err := conn.ModifyInstanceCreditSpecification(&ec2.ModifyInstanceCreditSpecificationInput{
+				InstanceCreditSpecifications: []*ec2.InstanceCreditSpecificationRequest{
+					{
+						InstanceId: aws.String(d.Id()),
+						CpuCredits: aws.String(creditSpecification["cpu_credits"].(string)),
+					},
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("Error modifying credit specification for Instance (%s): %s", d.Id(), err)
+			}
+			for _, u := range resp.UnsuccessfulInstanceCreditSpecifications {
+				if u.Error != nil {
+					return fmt.Errorf("Error modifying credit specification for Instance (%s): %s: %s",
+						d.Id(), aws.StringValue(u.Error.Code), aws.StringValue(u.Error.Message))
+				}
+			}
+		}
+	}
+
 	// TODO(mitchellh): wait for the attributes we modified to
 	// persist the change...
 
@@ -1546,6 +1613,7 @@ func readSecurityGroups(d *schema.ResourceData, instance *ec2.Instance, conn *ec
 
 type awsInstanceOpts struct {
 	BlockDeviceMappings               []*ec2.BlockDeviceMapping
+	CreditSpecification               *ec2.CreditSpecificationRequest
 	DisableAPITermination             *bool
 	EBSOptimized                      *bool
 	Monitoring                        *ec2.RunInstancesMonitoringEnabled
@@ -1581,6 +1649,16 @@ func buildAwsInstanceOpts(
 		opts.InstanceInitiatedShutdownBehavior = aws.String(v)
 	}
 
+	if v, ok := d.GetOk("credit_specification"); ok {
+		// Only T2 instances accept a credit specification
+		if strings.HasPrefix(d.Get("instance_type").(string), "t2.") {
+			cs := v.([]interface{})[0].(map[string]interface{})
+			opts.CreditSpecification = &ec2.CreditSpecificationRequest{
+				CpuCredits: aws.String(cs["cpu_credits"].(string)),
+			}
+		}
+	}
+
 	opts.Monitoring = &ec2.RunInstancesMonitoringEnabled{
 		Enabled: aws.Bool(d.Get("monitoring").(bool)),
 	}
@@ -1766,3 +1844,20 @@ func getAwsInstanceVolumeIds(conn *ec2.EC2, d *schema.ResourceData) ([]*string,
 
 	return volumeIds, nil
 }
+
+func getInstanceCreditSpecifications(conn *ec2.EC2, instanceId string) ([]map[string]interface{}, error) {
+	var creditSpecifications []map[string]interface{}
+	resp, err := conn.DescribeInstanceCreditSpecifications(&ec2.DescribeInstanceCreditSpecificationsInput{
+		InstanceIds: []*string{aws.String(instanceId)},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error describing credit specification for Instance (%s): %s", instanceId, err)
+	}
+	if len(resp.InstanceCreditSpecifications) > 0 {
+		creditSpecifications = append(creditSpecifications, map[string]interface{}{
+			"cpu_credits": aws.StringValue(resp.InstanceCreditSpecifications[0].CpuCredits),
+		})
+	}
+
+	return creditSpecifications, nil
+}
@@ -0,0 +1,123 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsIotPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotPolicyAttachmentCreate,
+		Read:   resourceAwsIotPolicyAttachmentRead,
+		Delete: resourceAwsIotPolicyAttachmentDelete,
+
+		Schema: map[string]*schema.Schema{
+			"policy": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIotPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	policyName := d.Get("policy").(string)
+	target := d.Get("target").(string)
+
+	params := &iot.AttachPrincipalPolicyInput{
+		PolicyName: aws.String(policyName),
+		Principal:  aws.String(target),
+	}
+	log.Printf("[DEBUG] Attaching IoT Policy %s to %s", policyName, target)
+	_, err := conn.AttachPrincipalPolicy(params)
+	if err != nil {
+		return fmt.Errorf("Error attaching IoT Policy %s to %s: %s", policyName, target, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", policyName, target))
+	return resourceAwsIotPolicyAttachmentRead(d, meta)
+}
+
+func listIotPrincipalPolicies(conn *iot.IoT, principal string) ([]*iot.Policy, error) {
+	var policies []*iot.Policy
+
+	params := &iot.ListPrincipalPoliciesInput{
+		Principal: aws.String(principal),
+	}
+	for {
+		out, err := conn.ListPrincipalPolicies(params)
+		if err != nil {
+			return nil, err
+		}
+
+		policies = append(policies, out.Policies...)
+
+		if out.NextMarker == nil || *out.NextMarker == "" {
+			break
+		}
+		params.Marker = out.NextMarker
+	}
+
+	return policies, nil
+}
+
+func resourceAwsIotPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	policyName := d.Get("policy").(string)
+	target := d.Get("target").(string)
+
+	policies, err := listIotPrincipalPolicies(conn, target)
+	if err != nil {
+		if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] IoT principal %s not found, removing policy attachment (%s) from state", target, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error listing IoT Policies for %s: %s", target, err)
+	}
+
+	for _, policy := range policies {
+		if aws.StringValue(policy.PolicyName) == policyName {
+			return nil
+		}
+	}
+
+	log.Printf("[WARN] IoT Policy Attachment (%s) not found, removing from state", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceAwsIotPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	policyName := d.Get("policy").(string)
+	target := d.Get("target").(string)
+
+	params := &iot.DetachPrincipalPolicyInput{
+		PolicyName: aws.String(policyName),
+		Principal:  aws.String(target),
+	}
+	log.Printf("[DEBUG] Detaching IoT Policy %s from %s", policyName, target)
+	_, err := conn.DetachPrincipalPolicy(params)
+	if err != nil {
+		if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error detaching IoT Policy %s from %s: %s", policyName, target, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,209 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSIotPolicyAttachment_basic(t *testing.T) {
+	policyName := acctest.RandomWithPrefix("PolicyName-")
+	policyName2 := acctest.RandomWithPrefix("PolicyName2-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotPolicyAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotPolicyAttachmentConfig(policyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotPolicyAttachmentExists("aws_iot_policy_attachment.att"),
+					testAccCheckAWSIotPolicyAttachmentCertStatus("aws_iot_certificate.cert", []string{policyName}),
+				),
+			},
+			{
+				Config: testAccAWSIotPolicyAttachmentConfigUpdate1(policyName, policyName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotPolicyAttachmentExists("aws_iot_policy_attachment.att"),
+					testAccCheckAWSIotPolicyAttachmentExists("aws_iot_policy_attachment.att2"),
+					testAccCheckAWSIotPolicyAttachmentCertStatus("aws_iot_certificate.cert", []string{policyName, policyName2}),
+				),
+			},
+			{
+				Config: testAccAWSIotPolicyAttachmentConfigUpdate2(policyName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotPolicyAttachmentExists("aws_iot_policy_attachment.att2"),
+					testAccCheckAWSIotPolicyAttachmentCertStatus("aws_iot_certificate.cert", []string{policyName2}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotPolicyAttachmentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).iotconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iot_policy_attachment" {
+			continue
+		}
+
+		target := rs.Primary.Attributes["target"]
+		policyName := rs.Primary.Attributes["policy"]
+
+		policies, err := listIotPrincipalPolicies(conn, target)
+		if err != nil {
+			if isAWSErr(err, "ResourceNotFoundException", "") {
+				continue
+			}
+			return err
+		}
+
+		for _, p := range policies {
+			if aws.StringValue(p.PolicyName) == policyName {
+				return fmt.Errorf("IoT Policy Attachment (%s) still exists", rs.Primary.ID)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckAWSIotPolicyAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No policy name is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).iotconn
+		target := rs.Primary.Attributes["target"]
+		policyName := rs.Primary.Attributes["policy"]
+
+		policies, err := listIotPrincipalPolicies(conn, target)
+		if err != nil {
+			return fmt.Errorf("Error: Failed to get attached policies for target %s (%s): %s", target, n, err)
+		}
+
+		for _, p := range policies {
+			if aws.StringValue(p.PolicyName) == policyName {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Error: Policy %s is not attached to target (%s)", policyName, target)
+	}
+}
+
+func testAccCheckAWSIotPolicyAttachmentCertStatus(n string, policies []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).iotconn
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		certARN := rs.Primary.Attributes["arn"]
+
+		out, err := listIotPrincipalPolicies(conn, certARN)
+		if err != nil {
+			return err
+		}
+
+		if len(policies) != len(out) {
+			return fmt.Errorf("Error: Invalid attached policies count for cert %s (%s), %d != %d", rs.Primary.ID, n, len(policies), len(out))
+		}
+
+		for _, p1 := range policies {
+			found := false
+			for _, p2 := range out {
+				if p1 == aws.StringValue(p2.PolicyName) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return fmt.Errorf("Error: Policy %s is not attached to cert %s (%s)", p1, rs.Primary.ID, n)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSIotPolicyAttachmentConfigPolicy(resourceName, policyName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_policy" "%s" {
+  name   = "%s"
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": ["iot:*"],
+      "Effect": "Allow",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+`, resourceName, policyName)
+}
+
+func testAccAWSIotPolicyAttachmentConfig(policyName string) string {
+	return `
+resource "aws_iot_certificate" "cert" {
+  csr    = "${file("test-fixtures/iot-csr.pem")}"
+  active = true
+}
+` + testAccAWSIotPolicyAttachmentConfigPolicy("policy", policyName) + `
+resource "aws_iot_policy_attachment" "att" {
+  policy = "${aws_iot_policy.policy.name}"
+  target = "${aws_iot_certificate.cert.arn}"
+}
+`
+}
+
+func testAccAWSIotPolicyAttachmentConfigUpdate1(policyName, policyName2 string) string {
+	return `
+resource "aws_iot_certificate" "cert" {
+  csr    = "${file("test-fixtures/iot-csr.pem")}"
+  active = true
+}
+` + testAccAWSIotPolicyAttachmentConfigPolicy("policy", policyName) +
+		testAccAWSIotPolicyAttachmentConfigPolicy("policy2", policyName2) + `
+resource "aws_iot_policy_attachment" "att" {
+  policy = "${aws_iot_policy.policy.name}"
+  target = "${aws_iot_certificate.cert.arn}"
+}
+
+resource "aws_iot_policy_attachment" "att2" {
+  policy = "${aws_iot_policy.policy2.name}"
+  target = "${aws_iot_certificate.cert.arn}"
+}
+`
+}
+
+func testAccAWSIotPolicyAttachmentConfigUpdate2(policyName2 string) string {
+	return `
+resource "aws_iot_certificate" "cert" {
+  csr    = "${file("test-fixtures/iot-csr.pem")}"
+  active = true
+}
+` + testAccAWSIotPolicyAttachmentConfigPolicy("policy2", policyName2) + `
+resource "aws_iot_policy_attachment" "att2" {
+  policy = "${aws_iot_policy.policy2.name}"
+  target = "${aws_iot_certificate.cert.arn}"
+}
+`
+}
@@ -0,0 +1,129 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsIotRoleAlias() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotRoleAliasCreate,
+		Read:   resourceAwsIotRoleAliasRead,
+		Update: resourceAwsIotRoleAliasUpdate,
+		Delete: resourceAwsIotRoleAliasDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alias": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"credential_duration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3600,
+				ValidateFunc: validation.IntBetween(900, 3600),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIotRoleAliasCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	roleAlias := d.Get("alias").(string)
+
+	params := &iot.CreateRoleAliasInput{
+		RoleAlias:                 aws.String(roleAlias),
+		RoleArn:                   aws.String(d.Get("role_arn").(string)),
+		CredentialDurationSeconds: aws.Int64(int64(d.Get("credential_duration").(int))),
+	}
+	log.Printf("[DEBUG] Creating IoT Role Alias: %s", params)
+	_, err := conn.CreateRoleAlias(params)
+	if err != nil {
+		return fmt.Errorf("Error creating IoT Role Alias %q: %s", roleAlias, err)
+	}
+
+	d.SetId(roleAlias)
+	return resourceAwsIotRoleAliasRead(d, meta)
+}
+
+func resourceAwsIotRoleAliasRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AWSClient)
+	conn := client.iotconn
+
+	out, err := conn.DescribeRoleAlias(&iot.DescribeRoleAliasInput{
+		RoleAlias: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] IoT Role Alias %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading IoT Role Alias %q: %s", d.Id(), err)
+	}
+
+	desc := out.RoleAliasDescription
+	d.Set("alias", desc.RoleAlias)
+	d.Set("role_arn", desc.RoleArn)
+	d.Set("credential_duration", desc.CredentialDurationSeconds)
+	d.Set("arn", arnString(client.partition, client.region, "iot", client.accountid, fmt.Sprintf("rolealias/%s", d.Id())))
+
+	return nil
+}
+
+func resourceAwsIotRoleAliasUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	if d.HasChange("role_arn") || d.HasChange("credential_duration") {
+		params := &iot.UpdateRoleAliasInput{
+			RoleAlias:                 aws.String(d.Id()),
+			RoleArn:                   aws.String(d.Get("role_arn").(string)),
+			CredentialDurationSeconds: aws.Int64(int64(d.Get("credential_duration").(int))),
+		}
+		log.Printf("[DEBUG] Updating IoT Role Alias: %s", params)
+		_, err := conn.UpdateRoleAlias(params)
+		if err != nil {
+			return fmt.Errorf("Error updating IoT Role Alias %q: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIotRoleAliasRead(d, meta)
+}
+
+func resourceAwsIotRoleAliasDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	log.Printf("[DEBUG] Deleting IoT Role Alias %q", d.Id())
+	_, err := conn.DeleteRoleAlias(&iot.DeleteRoleAliasInput{
+		RoleAlias: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting IoT Role Alias %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSIotRoleAlias_basic(t *testing.T) {
+	alias := acctest.RandomWithPrefix("RoleAlias-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotRoleAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotRoleAliasConfig(alias, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotRoleAliasExists("aws_iot_role_alias.ra"),
+					resource.TestCheckResourceAttr("aws_iot_role_alias.ra", "alias", alias),
+					resource.TestCheckResourceAttr("aws_iot_role_alias.ra", "credential_duration", "3600"),
+					resource.TestCheckResourceAttrSet("aws_iot_role_alias.ra", "arn"),
+				),
+			},
+			{
+				Config: testAccAWSIotRoleAliasConfig(alias, 1800),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotRoleAliasExists("aws_iot_role_alias.ra"),
+					resource.TestCheckResourceAttr("aws_iot_role_alias.ra", "credential_duration", "1800"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIotRoleAlias_importBasic(t *testing.T) {
+	resourceName := "aws_iot_role_alias.ra"
+	alias := acctest.RandomWithPrefix("RoleAlias-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotRoleAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotRoleAliasConfig(alias, 3600),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotRoleAliasDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).iotconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iot_role_alias" {
+			continue
+		}
+
+		_, err := conn.DescribeRoleAlias(&iot.DescribeRoleAliasInput{
+			RoleAlias: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("IoT Role Alias (%s) still exists", rs.Primary.ID)
+	}
+	return nil
+}
+
+func testAccCheckAWSIotRoleAliasExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Role Alias ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).iotconn
+		_, err := conn.DescribeRoleAlias(&iot.DescribeRoleAliasInput{
+			RoleAlias: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSIotRoleAliasConfig(alias string, duration int) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "role" {
+  name = "%[1]s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": "credentials.iot.amazonaws.com"},
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iot_role_alias" "ra" {
+  alias               = "%[1]s"
+  role_arn            = "${aws_iam_role.role.arn}"
+  credential_duration = %[2]d
+}
+`, alias, duration)
+}
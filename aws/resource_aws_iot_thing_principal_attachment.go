@@ -0,0 +1,111 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsIotThingPrincipalAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotThingPrincipalAttachmentCreate,
+		Read:   resourceAwsIotThingPrincipalAttachmentRead,
+		Delete: resourceAwsIotThingPrincipalAttachmentDelete,
+
+		Schema: map[string]*schema.Schema{
+			"principal": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"thing": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIotThingPrincipalAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	principal := d.Get("principal").(string)
+	thing := d.Get("thing").(string)
+
+	params := &iot.AttachThingPrincipalInput{
+		Principal: aws.String(principal),
+		ThingName: aws.String(thing),
+	}
+	log.Printf("[DEBUG] Attaching principal %s to IoT Thing %s", principal, thing)
+	_, err := conn.AttachThingPrincipal(params)
+	if err != nil {
+		return fmt.Errorf("Error attaching principal %s to IoT Thing %s: %s", principal, thing, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", thing, principal))
+	return resourceAwsIotThingPrincipalAttachmentRead(d, meta)
+}
+
+func getIoTThingPrincipalAttachment(conn *iot.IoT, thing, principal string) (bool, error) {
+	out, err := conn.ListThingPrincipals(&iot.ListThingPrincipalsInput{
+		ThingName: aws.String(thing),
+	})
+	if err != nil {
+		if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+			return false, nil
+		}
+		return false, err
+	}
+
+	for _, p := range out.Principals {
+		if aws.StringValue(p) == principal {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func resourceAwsIotThingPrincipalAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	principal := d.Get("principal").(string)
+	thing := d.Get("thing").(string)
+
+	found, err := getIoTThingPrincipalAttachment(conn, thing, principal)
+	if err != nil {
+		return fmt.Errorf("Error listing principals for IoT Thing %s: %s", thing, err)
+	}
+
+	if !found {
+		log.Printf("[WARN] IoT Thing Principal Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceAwsIotThingPrincipalAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	principal := d.Get("principal").(string)
+	thing := d.Get("thing").(string)
+
+	params := &iot.DetachThingPrincipalInput{
+		Principal: aws.String(principal),
+		ThingName: aws.String(thing),
+	}
+	log.Printf("[DEBUG] Detaching principal %s from IoT Thing %s", principal, thing)
+	_, err := conn.DetachThingPrincipal(params)
+	if err != nil {
+		if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error detaching principal %s from IoT Thing %s: %s", principal, thing, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSIotThingType_basic(t *testing.T) {
+	rName := acctest.RandString(8)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotThingTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotThingTypeConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_iot_thing_type.foo", "name", fmt.Sprintf("tf_acc_iot_thing_type_%s", rName)),
+					resource.TestCheckResourceAttrSet("aws_iot_thing_type.foo", "arn"),
+					resource.TestCheckResourceAttr("aws_iot_thing_type.foo", "deprecated", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIotThingType_full(t *testing.T) {
+	rName := acctest.RandString(8)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotThingTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotThingTypeConfig_full(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("aws_iot_thing_type.foo", "arn"),
+					resource.TestCheckResourceAttr("aws_iot_thing_type.foo", "properties.0.description", "MyDescription"),
+					resource.TestCheckResourceAttr("aws_iot_thing_type.foo", "properties.0.searchable_attributes.#", "3"),
+					resource.TestCheckResourceAttr("aws_iot_thing_type.foo", "deprecated", "true"),
+				),
+			},
+			{
+				Config: testAccAWSIotThingTypeConfig_full(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_iot_thing_type.foo", "deprecated", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIotThingType_importBasic(t *testing.T) {
+	resourceName := "aws_iot_thing_type.foo"
+	rName := acctest.RandString(8)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotThingTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotThingTypeConfig_basic(rName),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotThingTypeDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).iotconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iot_thing_type" {
+			continue
+		}
+
+		_, err := conn.DescribeThingType(&iot.DescribeThingTypeInput{
+			ThingTypeName: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Expected IoT Thing Type to be destroyed, %s found", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSIotThingTypeConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_thing_type" "foo" {
+  name = "tf_acc_iot_thing_type_%s"
+}
+`, rName)
+}
+
+func testAccAWSIotThingTypeConfig_full(rName string, deprecated bool) string {
+	return fmt.Sprintf(`
+resource "aws_iot_thing_type" "foo" {
+  name       = "tf_acc_iot_thing_type_%s"
+  deprecated = %t
+
+  properties {
+    description           = "MyDescription"
+    searchable_attributes = ["foo", "bar", "baz"]
+  }
+}
+`, rName, deprecated)
+}
@@ -0,0 +1,696 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsIotTopicRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotTopicRuleCreate,
+		Read:   resourceAwsIotTopicRuleRead,
+		Update: resourceAwsIotTopicRuleUpdate,
+		Delete: resourceAwsIotTopicRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIoTTopicRuleName,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"sql": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"sql_version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cloudwatch_alarm": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"state_reason": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"state_value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateIoTTopicRuleCloudWatchAlarmStateValue,
+						},
+					},
+				},
+			},
+			"cloudwatch_metric": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"metric_namespace": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"metric_timestamp": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"metric_unit": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"metric_value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"dynamodb": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hash_key_field": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"hash_key_value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"hash_key_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								iot.DynamoKeyTypeString,
+								iot.DynamoKeyTypeNumber,
+							}, false),
+						},
+						"payload_field": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"range_key_field": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"range_key_value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"range_key_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								iot.DynamoKeyTypeString,
+								iot.DynamoKeyTypeNumber,
+							}, false),
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"table_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"elasticsearch": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateIoTTopicRuleElasticSearchEndpoint,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"index": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"firehose": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delivery_stream_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"separator": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateIoTTopicRuleFirehoseSeparator,
+						},
+					},
+				},
+			},
+			"kinesis": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"partition_key": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"stream_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"lambda": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"function_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"republish": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"topic": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"s3": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"sns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"message_format": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  iot.MessageFormatRaw,
+							ValidateFunc: validation.StringInSlice([]string{
+								iot.MessageFormatRaw,
+								iot.MessageFormatJson,
+							}, false),
+						},
+						"target_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"sqs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"queue_url": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"use_base64": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIotTopicRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	ruleName := d.Get("name").(string)
+
+	params := &iot.CreateTopicRuleInput{
+		RuleName:         aws.String(ruleName),
+		TopicRulePayload: expandIotTopicRulePayload(d),
+	}
+	log.Printf("[DEBUG] Creating IoT Topic Rule: %s", params)
+	_, err := conn.CreateTopicRule(params)
+
+	if err != nil {
+		return fmt.Errorf("Error creating IoT Topic Rule %q: %s", ruleName, err)
+	}
+
+	d.SetId(ruleName)
+
+	return resourceAwsIotTopicRuleRead(d, meta)
+}
+
+func resourceAwsIotTopicRuleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	params := &iot.GetTopicRuleInput{
+		RuleName: aws.String(d.Id()),
+	}
+	log.Printf("[DEBUG] Reading IoT Topic Rule: %s", params)
+	out, err := conn.GetTopicRule(params)
+
+	if err != nil {
+		if isAWSErr(err, iot.ErrCodeUnauthorizedException, "") {
+			// GetTopicRule returns UnauthorizedException for rules that do not exist.
+			log.Printf("[WARN] IoT Topic Rule %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading IoT Topic Rule %q: %s", d.Id(), err)
+	}
+
+	d.Set("arn", out.RuleArn)
+	d.Set("name", out.Rule.RuleName)
+	d.Set("description", out.Rule.Description)
+	d.Set("enabled", !aws.BoolValue(out.Rule.RuleDisabled))
+	d.Set("sql", out.Rule.Sql)
+	d.Set("sql_version", out.Rule.AwsIotSqlVersion)
+
+	actions := flattenIotTopicRuleActions(out.Rule.Actions)
+	for k, v := range actions {
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("Error setting %s: %s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsIotTopicRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	params := &iot.ReplaceTopicRuleInput{
+		RuleName:         aws.String(d.Get("name").(string)),
+		TopicRulePayload: expandIotTopicRulePayload(d),
+	}
+	log.Printf("[DEBUG] Updating IoT Topic Rule: %s", params)
+	_, err := conn.ReplaceTopicRule(params)
+
+	if err != nil {
+		return fmt.Errorf("Error updating IoT Topic Rule %q: %s", d.Id(), err)
+	}
+
+	return resourceAwsIotTopicRuleRead(d, meta)
+}
+
+func resourceAwsIotTopicRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	params := &iot.DeleteTopicRuleInput{
+		RuleName: aws.String(d.Id()),
+	}
+	log.Printf("[DEBUG] Deleting IoT Topic Rule: %s", params)
+	_, err := conn.DeleteTopicRule(params)
+
+	if err != nil {
+		return fmt.Errorf("Error deleting IoT Topic Rule %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIotTopicRulePayload(d *schema.ResourceData) *iot.TopicRulePayload {
+	actions := make([]*iot.Action, 0)
+
+	for _, a := range d.Get("cloudwatch_alarm").(*schema.Set).List() {
+		raw := a.(map[string]interface{})
+		actions = append(actions, &iot.Action{
+			CloudwatchAlarm: &iot.CloudwatchAlarmAction{
+				AlarmName:   aws.String(raw["alarm_name"].(string)),
+				RoleArn:     aws.String(raw["role_arn"].(string)),
+				StateReason: aws.String(raw["state_reason"].(string)),
+				StateValue:  aws.String(raw["state_value"].(string)),
+			},
+		})
+	}
+
+	for _, a := range d.Get("cloudwatch_metric").(*schema.Set).List() {
+		raw := a.(map[string]interface{})
+		act := &iot.Action{
+			CloudwatchMetric: &iot.CloudwatchMetricAction{
+				MetricName:      aws.String(raw["metric_name"].(string)),
+				MetricNamespace: aws.String(raw["metric_namespace"].(string)),
+				MetricUnit:      aws.String(raw["metric_unit"].(string)),
+				MetricValue:     aws.String(raw["metric_value"].(string)),
+				RoleArn:         aws.String(raw["role_arn"].(string)),
+			},
+		}
+		if v, ok := raw["metric_timestamp"].(string); ok && v != "" {
+			act.CloudwatchMetric.MetricTimestamp = aws.String(v)
+		}
+		actions = append(actions, act)
+	}
+
+	for _, a := range d.Get("dynamodb").(*schema.Set).List() {
+		raw := a.(map[string]interface{})
+		act := &iot.Action{
+			DynamoDB: &iot.DynamoDBAction{
+				HashKeyField: aws.String(raw["hash_key_field"].(string)),
+				HashKeyValue: aws.String(raw["hash_key_value"].(string)),
+				RoleArn:      aws.String(raw["role_arn"].(string)),
+				TableName:    aws.String(raw["table_name"].(string)),
+			},
+		}
+		if v, ok := raw["hash_key_type"].(string); ok && v != "" {
+			act.DynamoDB.HashKeyType = aws.String(v)
+		}
+		if v, ok := raw["payload_field"].(string); ok && v != "" {
+			act.DynamoDB.PayloadField = aws.String(v)
+		}
+		if v, ok := raw["range_key_field"].(string); ok && v != "" {
+			act.DynamoDB.RangeKeyField = aws.String(v)
+		}
+		if v, ok := raw["range_key_value"].(string); ok && v != "" {
+			act.DynamoDB.RangeKeyValue = aws.String(v)
+		}
+		if v, ok := raw["range_key_type"].(string); ok && v != "" {
+			act.DynamoDB.RangeKeyType = aws.String(v)
+		}
+		actions = append(actions, act)
+	}
+
+	for _, a := range d.Get("elasticsearch").(*schema.Set).List() {
+		raw := a.(map[string]interface{})
+		actions = append(actions, &iot.Action{
+			Elasticsearch: &iot.ElasticsearchAction{
+				Endpoint: aws.String(raw["endpoint"].(string)),
+				Id:       aws.String(raw["id"].(string)),
+				Index:    aws.String(raw["index"].(string)),
+				RoleArn:  aws.String(raw["role_arn"].(string)),
+				Type:     aws.String(raw["type"].(string)),
+			},
+		})
+	}
+
+	for _, a := range d.Get("firehose").(*schema.Set).List() {
+		raw := a.(map[string]interface{})
+		act := &iot.Action{
+			Firehose: &iot.FirehoseAction{
+				DeliveryStreamName: aws.String(raw["delivery_stream_name"].(string)),
+				RoleArn:            aws.String(raw["role_arn"].(string)),
+			},
+		}
+		if v, ok := raw["separator"].(string); ok && v != "" {
+			act.Firehose.Separator = aws.String(v)
+		}
+		actions = append(actions, act)
+	}
+
+	for _, a := range d.Get("kinesis").(*schema.Set).List() {
+		raw := a.(map[string]interface{})
+		act := &iot.Action{
+			Kinesis: &iot.KinesisAction{
+				RoleArn:    aws.String(raw["role_arn"].(string)),
+				StreamName: aws.String(raw["stream_name"].(string)),
+			},
+		}
+		if v, ok := raw["partition_key"].(string); ok && v != "" {
+			act.Kinesis.PartitionKey = aws.String(v)
+		}
+		actions = append(actions, act)
+	}
+
+	for _, a := range d.Get("lambda").(*schema.Set).List() {
+		raw := a.(map[string]interface{})
+		actions = append(actions, &iot.Action{
+			Lambda: &iot.LambdaAction{
+				FunctionArn: aws.String(raw["function_arn"].(string)),
+			},
+		})
+	}
+
+	for _, a := range d.Get("republish").(*schema.Set).List() {
+		raw := a.(map[string]interface{})
+		actions = append(actions, &iot.Action{
+			Republish: &iot.RepublishAction{
+				RoleArn: aws.String(raw["role_arn"].(string)),
+				Topic:   aws.String(raw["topic"].(string)),
+			},
+		})
+	}
+
+	for _, a := range d.Get("s3").(*schema.Set).List() {
+		raw := a.(map[string]interface{})
+		actions = append(actions, &iot.Action{
+			S3: &iot.S3Action{
+				BucketName: aws.String(raw["bucket_name"].(string)),
+				Key:        aws.String(raw["key"].(string)),
+				RoleArn:    aws.String(raw["role_arn"].(string)),
+			},
+		})
+	}
+
+	for _, a := range d.Get("sns").(*schema.Set).List() {
+		raw := a.(map[string]interface{})
+		actions = append(actions, &iot.Action{
+			Sns: &iot.SnsAction{
+				RoleArn:       aws.String(raw["role_arn"].(string)),
+				TargetArn:     aws.String(raw["target_arn"].(string)),
+				MessageFormat: aws.String(raw["message_format"].(string)),
+			},
+		})
+	}
+
+	for _, a := range d.Get("sqs").(*schema.Set).List() {
+		raw := a.(map[string]interface{})
+		actions = append(actions, &iot.Action{
+			Sqs: &iot.SqsAction{
+				QueueUrl:  aws.String(raw["queue_url"].(string)),
+				RoleArn:   aws.String(raw["role_arn"].(string)),
+				UseBase64: aws.Bool(raw["use_base64"].(bool)),
+			},
+		})
+	}
+
+	return &iot.TopicRulePayload{
+		Description:      aws.String(d.Get("description").(string)),
+		RuleDisabled:     aws.Bool(!d.Get("enabled").(bool)),
+		Sql:              aws.String(d.Get("sql").(string)),
+		AwsIotSqlVersion: aws.String(d.Get("sql_version").(string)),
+		Actions:          actions,
+	}
+}
+
+func flattenIotTopicRuleActions(actions []*iot.Action) map[string][]map[string]interface{} {
+	result := map[string][]map[string]interface{}{
+		"cloudwatch_alarm":  {},
+		"cloudwatch_metric": {},
+		"dynamodb":          {},
+		"elasticsearch":     {},
+		"firehose":          {},
+		"kinesis":           {},
+		"lambda":            {},
+		"republish":         {},
+		"s3":                {},
+		"sns":               {},
+		"sqs":               {},
+	}
+
+	for _, a := range actions {
+		if v := a.CloudwatchAlarm; v != nil {
+			result["cloudwatch_alarm"] = append(result["cloudwatch_alarm"], map[string]interface{}{
+				"alarm_name":   aws.StringValue(v.AlarmName),
+				"role_arn":     aws.StringValue(v.RoleArn),
+				"state_reason": aws.StringValue(v.StateReason),
+				"state_value":  aws.StringValue(v.StateValue),
+			})
+		}
+		if v := a.CloudwatchMetric; v != nil {
+			result["cloudwatch_metric"] = append(result["cloudwatch_metric"], map[string]interface{}{
+				"metric_name":      aws.StringValue(v.MetricName),
+				"metric_namespace": aws.StringValue(v.MetricNamespace),
+				"metric_timestamp": aws.StringValue(v.MetricTimestamp),
+				"metric_unit":      aws.StringValue(v.MetricUnit),
+				"metric_value":     aws.StringValue(v.MetricValue),
+				"role_arn":         aws.StringValue(v.RoleArn),
+			})
+		}
+		if v := a.DynamoDB; v != nil {
+			result["dynamodb"] = append(result["dynamodb"], map[string]interface{}{
+				"hash_key_field":  aws.StringValue(v.HashKeyField),
+				"hash_key_value":  aws.StringValue(v.HashKeyValue),
+				"hash_key_type":   aws.StringValue(v.HashKeyType),
+				"payload_field":   aws.StringValue(v.PayloadField),
+				"range_key_field": aws.StringValue(v.RangeKeyField),
+				"range_key_value": aws.StringValue(v.RangeKeyValue),
+				"range_key_type":  aws.StringValue(v.RangeKeyType),
+				"role_arn":        aws.StringValue(v.RoleArn),
+				"table_name":      aws.StringValue(v.TableName),
+			})
+		}
+		if v := a.Elasticsearch; v != nil {
+			result["elasticsearch"] = append(result["elasticsearch"], map[string]interface{}{
+				"endpoint": aws.StringValue(v.Endpoint),
+				"id":       aws.StringValue(v.Id),
+				"index":    aws.StringValue(v.Index),
+				"role_arn": aws.StringValue(v.RoleArn),
+				"type":     aws.StringValue(v.Type),
+			})
+		}
+		if v := a.Firehose; v != nil {
+			result["firehose"] = append(result["firehose"], map[string]interface{}{
+				"delivery_stream_name": aws.StringValue(v.DeliveryStreamName),
+				"role_arn":             aws.StringValue(v.RoleArn),
+				"separator":            aws.StringValue(v.Separator),
+			})
+		}
+		if v := a.Kinesis; v != nil {
+			result["kinesis"] = append(result["kinesis"], map[string]interface{}{
+				"partition_key": aws.StringValue(v.PartitionKey),
+				"role_arn":      aws.StringValue(v.RoleArn),
+				"stream_name":   aws.StringValue(v.StreamName),
+			})
+		}
+		if v := a.Lambda; v != nil {
+			result["lambda"] = append(result["lambda"], map[string]interface{}{
+				"function_arn": aws.StringValue(v.FunctionArn),
+			})
+		}
+		if v := a.Republish; v != nil {
+			result["republish"] = append(result["republish"], map[string]interface{}{
+				"role_arn": aws.StringValue(v.RoleArn),
+				"topic":    aws.StringValue(v.Topic),
+			})
+		}
+		if v := a.S3; v != nil {
+			result["s3"] = append(result["s3"], map[string]interface{}{
+				"bucket_name": aws.StringValue(v.BucketName),
+				"key":         aws.StringValue(v.Key),
+				"role_arn":    aws.StringValue(v.RoleArn),
+			})
+		}
+		if v := a.Sns; v != nil {
+			result["sns"] = append(result["sns"], map[string]interface{}{
+				"message_format": aws.StringValue(v.MessageFormat),
+				"role_arn":       aws.StringValue(v.RoleArn),
+				"target_arn":     aws.StringValue(v.TargetArn),
+			})
+		}
+		if v := a.Sqs; v != nil {
+			result["sqs"] = append(result["sqs"], map[string]interface{}{
+				"queue_url":  aws.StringValue(v.QueueUrl),
+				"role_arn":   aws.StringValue(v.RoleArn),
+				"use_base64": aws.BoolValue(v.UseBase64),
+			})
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,397 @@
+package aws
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsKmsExternalKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsKmsExternalKeyCreate,
+		Read:   resourceAwsKmsExternalKeyRead,
+		Update: resourceAwsKmsExternalKeyUpdate,
+		Delete: resourceAwsKmsExternalKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deletion_window_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(7, 30),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 8192),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"expiration_model": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_material_base64": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"key_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_usage": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+			},
+			"tags": tagsSchema(),
+			"valid_to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRFC3339TimeString,
+			},
+		},
+	}
+}
+
+func resourceAwsKmsExternalKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	input := &kms.CreateKeyInput{
+		KeyUsage: aws.String(kms.KeyUsageTypeEncryptDecrypt),
+		Origin:   aws.String(kms.OriginTypeExternal),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("policy"); ok {
+		input.Policy = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapKMS(v.(map[string]interface{}))
+	}
+
+	var output *kms.CreateKeyOutput
+	// AWS requires any principal in the policy to exist before the key is created.
+	// The KMS service's awareness of principals is limited by "eventual consistency".
+	// KMS will report this error until it can validate the policy itself.
+	// They acknowledge this here:
+	// http://docs.aws.amazon.com/kms/latest/APIReference/API_CreateKey.html
+	log.Printf("[DEBUG] Creating KMS external key: %s", input)
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		var err error
+		output, err = conn.CreateKey(input)
+		if isAWSErr(err, kms.ErrCodeMalformedPolicyDocumentException, "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating KMS external key: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.KeyMetadata.KeyId))
+
+	if v, ok := d.GetOk("key_material_base64"); ok {
+		if err := importKmsExternalKeyMaterial(conn, d.Id(), v.(string), d.Get("valid_to").(string)); err != nil {
+			return fmt.Errorf("Error importing KMS external key (%s) material: %s", d.Id(), err)
+		}
+
+		if err := waitForKmsKeyState(conn, d.Id(), []string{kms.KeyStatePendingImport}, []string{kms.KeyStateEnabled}); err != nil {
+			return fmt.Errorf("Error waiting for KMS external key (%s) to be enabled: %s", d.Id(), err)
+		}
+
+		// Imported keys are enabled by default.
+		if v, ok := d.GetOkExists("enabled"); ok && !v.(bool) {
+			if err := updateKmsKeyStatus(conn, d.Id(), false); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceAwsKmsExternalKeyRead(d, meta)
+}
+
+func resourceAwsKmsExternalKeyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	input := &kms.DescribeKeyInput{
+		KeyId: aws.String(d.Id()),
+	}
+
+	var output *kms.DescribeKeyOutput
+	var err error
+	if d.IsNewResource() {
+		var out interface{}
+		out, err = retryOnAwsCode(kms.ErrCodeNotFoundException, func() (interface{}, error) {
+			return conn.DescribeKey(input)
+		})
+		output, _ = out.(*kms.DescribeKeyOutput)
+	} else {
+		output, err = conn.DescribeKey(input)
+	}
+	if err != nil {
+		if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] KMS external key (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error describing KMS external key (%s): %s", d.Id(), err)
+	}
+
+	metadata := output.KeyMetadata
+	if aws.StringValue(metadata.KeyState) == kms.KeyStatePendingDeletion {
+		log.Printf("[WARN] KMS external key (%s) is pending deletion, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", metadata.Arn)
+	d.Set("description", metadata.Description)
+	d.Set("enabled", metadata.Enabled)
+	d.Set("expiration_model", metadata.ExpirationModel)
+	d.Set("key_state", metadata.KeyState)
+	d.Set("key_usage", metadata.KeyUsage)
+	if metadata.ValidTo != nil {
+		d.Set("valid_to", aws.TimeValue(metadata.ValidTo).UTC().Format(time.RFC3339))
+	} else {
+		d.Set("valid_to", "")
+	}
+
+	policyOutput, err := conn.GetKeyPolicy(&kms.GetKeyPolicyInput{
+		KeyId:      metadata.KeyId,
+		PolicyName: aws.String("default"),
+	})
+	if err != nil {
+		return fmt.Errorf("Error getting KMS external key (%s) policy: %s", d.Id(), err)
+	}
+
+	policy, err := normalizeJsonString(aws.StringValue(policyOutput.Policy))
+	if err != nil {
+		return errwrap.Wrapf("policy contains an invalid JSON: {{err}}", err)
+	}
+	d.Set("policy", policy)
+
+	tagsOutput, err := conn.ListResourceTags(&kms.ListResourceTagsInput{
+		KeyId: metadata.KeyId,
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing KMS external key (%s) tags: %s", d.Id(), err)
+	}
+	if err := d.Set("tags", tagsToMapKMS(tagsOutput.Tags)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAwsKmsExternalKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	// Keys without imported key material can be neither enabled nor disabled.
+	canToggle := d.Get("key_state").(string) != kms.KeyStatePendingImport
+
+	if d.HasChange("enabled") && d.Get("enabled").(bool) && canToggle {
+		// Enable before any attributes are modified
+		if err := updateKmsKeyStatus(conn, d.Id(), true); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("description") {
+		input := &kms.UpdateKeyDescriptionInput{
+			Description: aws.String(d.Get("description").(string)),
+			KeyId:       aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating KMS external key description: %s", input)
+		if _, err := conn.UpdateKeyDescription(input); err != nil {
+			return fmt.Errorf("Error updating KMS external key (%s) description: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("policy") {
+		policy, err := normalizeJsonString(d.Get("policy").(string))
+		if err != nil {
+			return errwrap.Wrapf("policy contains an invalid JSON: {{err}}", err)
+		}
+
+		input := &kms.PutKeyPolicyInput{
+			KeyId:      aws.String(d.Id()),
+			Policy:     aws.String(policy),
+			PolicyName: aws.String("default"),
+		}
+
+		log.Printf("[DEBUG] Updating KMS external key policy: %s", input)
+		if _, err := conn.PutKeyPolicy(input); err != nil {
+			return fmt.Errorf("Error updating KMS external key (%s) policy: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("enabled") && !d.Get("enabled").(bool) && canToggle {
+		// Only disable when all attributes are modified
+		// because we cannot modify disabled keys
+		if err := updateKmsKeyStatus(conn, d.Id(), false); err != nil {
+			return err
+		}
+	}
+
+	if err := setTagsKMS(conn, d, d.Id()); err != nil {
+		return err
+	}
+
+	return resourceAwsKmsExternalKeyRead(d, meta)
+}
+
+func resourceAwsKmsExternalKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	input := &kms.ScheduleKeyDeletionInput{
+		KeyId:               aws.String(d.Id()),
+		PendingWindowInDays: aws.Int64(int64(d.Get("deletion_window_in_days").(int))),
+	}
+
+	log.Printf("[DEBUG] Scheduling KMS external key (%s) for deletion: %s", d.Id(), input)
+	_, err := conn.ScheduleKeyDeletion(input)
+	if err != nil {
+		if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		if isAWSErr(err, kms.ErrCodeInvalidStateException, "is pending deletion") {
+			return nil
+		}
+		return fmt.Errorf("Error scheduling deletion for KMS external key (%s): %s", d.Id(), err)
+	}
+
+	pending := []string{kms.KeyStateDisabled, kms.KeyStateEnabled, kms.KeyStatePendingImport}
+	if err := waitForKmsKeyState(conn, d.Id(), pending, []string{kms.KeyStatePendingDeletion}); err != nil {
+		return fmt.Errorf("Error waiting for KMS external key (%s) to schedule deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// importKmsExternalKeyMaterial wraps the given base64-encoded 256-bit symmetric
+// key material with a freshly issued KMS wrapping key and imports it.
+func importKmsExternalKeyMaterial(conn *kms.KMS, keyId, keyMaterialBase64, validTo string) error {
+	keyMaterial, err := base64.StdEncoding.DecodeString(keyMaterialBase64)
+	if err != nil {
+		return fmt.Errorf("error Base64 decoding key material: %s", err)
+	}
+
+	getParametersForImportInput := &kms.GetParametersForImportInput{
+		KeyId:             aws.String(keyId),
+		WrappingAlgorithm: aws.String(kms.AlgorithmSpecRsaesOaepSha256),
+		WrappingKeySpec:   aws.String(kms.WrappingKeySpecRsa2048),
+	}
+
+	log.Printf("[DEBUG] Getting KMS external key (%s) import parameters", keyId)
+	getParametersForImportOutput, err := conn.GetParametersForImport(getParametersForImportInput)
+	if err != nil {
+		return fmt.Errorf("error getting parameters for import: %s", err)
+	}
+
+	pubKey, err := x509.ParsePKIXPublicKey(getParametersForImportOutput.PublicKey)
+	if err != nil {
+		return fmt.Errorf("error parsing public key: %s", err)
+	}
+	rsaPubKey, ok := pubKey.(*rsa.PublicKey)
+	if !ok {
+		return fmt.Errorf("unexpected wrapping public key type: %T", pubKey)
+	}
+
+	encryptedKeyMaterial, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, rsaPubKey, keyMaterial, []byte{})
+	if err != nil {
+		return fmt.Errorf("error encrypting key material: %s", err)
+	}
+
+	importKeyMaterialInput := &kms.ImportKeyMaterialInput{
+		EncryptedKeyMaterial: encryptedKeyMaterial,
+		ExpirationModel:      aws.String(kms.ExpirationModelTypeKeyMaterialDoesNotExpire),
+		ImportToken:          getParametersForImportOutput.ImportToken,
+		KeyId:                aws.String(keyId),
+	}
+
+	if validTo != "" {
+		t, err := time.Parse(time.RFC3339, validTo)
+		if err != nil {
+			return fmt.Errorf("error parsing valid_to timestamp: %s", err)
+		}
+
+		importKeyMaterialInput.ExpirationModel = aws.String(kms.ExpirationModelTypeKeyMaterialExpires)
+		importKeyMaterialInput.ValidTo = aws.Time(t)
+	}
+
+	log.Printf("[DEBUG] Importing KMS external key (%s) material", keyId)
+	// A newly created key may not be visible to KMS yet.
+	_, err = retryOnAwsCode(kms.ErrCodeNotFoundException, func() (interface{}, error) {
+		return conn.ImportKeyMaterial(importKeyMaterialInput)
+	})
+	return err
+}
+
+func waitForKmsKeyState(conn *kms.KMS, keyId string, pending []string, target []string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  target,
+		Refresh: func() (interface{}, string, error) {
+			output, err := conn.DescribeKey(&kms.DescribeKeyInput{
+				KeyId: aws.String(keyId),
+			})
+			if err != nil {
+				return nil, "", err
+			}
+
+			if output == nil || output.KeyMetadata == nil {
+				return nil, "", nil
+			}
+
+			return output, aws.StringValue(output.KeyMetadata.KeyState), nil
+		},
+		Timeout:    20 * time.Minute,
+		MinTimeout: 2 * time.Second,
+		// KMS is eventually consistent, so wait for the state to settle.
+		ContinuousTargetOccurence: 10,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
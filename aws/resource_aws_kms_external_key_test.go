@@ -0,0 +1,249 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSKmsExternalKey_basic(t *testing.T) {
+	var key kms.KeyMetadata
+	rName := fmt.Sprintf("tf-acc-test-kms-key-%s", acctest.RandString(13))
+	resourceName := "aws_kms_external_key.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsExternalKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsExternalKeyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "expiration_model", ""),
+					resource.TestCheckResourceAttr(resourceName, "key_state", kms.KeyStatePendingImport),
+					resource.TestCheckResourceAttr(resourceName, "key_usage", kms.KeyUsageTypeEncryptDecrypt),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_window_in_days"},
+			},
+		},
+	})
+}
+
+func TestAccAWSKmsExternalKey_keyMaterial(t *testing.T) {
+	var key kms.KeyMetadata
+	rName := fmt.Sprintf("tf-acc-test-kms-key-%s", acctest.RandString(13))
+	resourceName := "aws_kms_external_key.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsExternalKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsExternalKeyConfigKeyMaterial(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key),
+					testAccCheckAWSKmsKeyIsEnabled(&key, true),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "expiration_model", kms.ExpirationModelTypeKeyMaterialDoesNotExpire),
+					resource.TestCheckResourceAttr(resourceName, "key_state", kms.KeyStateEnabled),
+					resource.TestCheckResourceAttr(resourceName, "valid_to", ""),
+				),
+			},
+			{
+				Config: testAccAWSKmsExternalKeyConfigKeyMaterial(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key),
+					testAccCheckAWSKmsKeyIsEnabled(&key, false),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "key_state", kms.KeyStateDisabled),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSKmsExternalKey_validTo(t *testing.T) {
+	var key kms.KeyMetadata
+	rName := fmt.Sprintf("tf-acc-test-kms-key-%s", acctest.RandString(13))
+	resourceName := "aws_kms_external_key.test"
+	validTo := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsExternalKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsExternalKeyConfigValidTo(rName, validTo),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "expiration_model", kms.ExpirationModelTypeKeyMaterialExpires),
+					resource.TestCheckResourceAttr(resourceName, "valid_to", validTo),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSKmsExternalKey_update(t *testing.T) {
+	var key kms.KeyMetadata
+	rName := fmt.Sprintf("tf-acc-test-kms-key-%s", acctest.RandString(13))
+	resourceName := "aws_kms_external_key.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsExternalKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsExternalKeyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				Config: testAccAWSKmsExternalKeyConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key),
+					testAccCheckAWSKmsKeyHasPolicy(resourceName, `{
+  "Version": "2012-10-17",
+  "Id": "kms-tf-1",
+  "Statement": [
+    {
+      "Sid": "Enable IAM User Permissions",
+      "Effect": "Allow",
+      "Principal": {
+        "AWS": "*"
+      },
+      "Action": "kms:*",
+      "Resource": "*"
+    }
+  ]
+}`),
+					resource.TestCheckResourceAttr(resourceName, "description", rName+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSKmsExternalKeyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).kmsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kms_external_key" {
+			continue
+		}
+
+		out, err := conn.DescribeKey(&kms.DescribeKeyInput{
+			KeyId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if *out.KeyMetadata.KeyState == kms.KeyStatePendingDeletion {
+			continue
+		}
+
+		return fmt.Errorf("KMS external key still exists:\n%#v", out.KeyMetadata)
+	}
+
+	return nil
+}
+
+func testAccAWSKmsExternalKeyConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_external_key" "test" {
+  description             = "%s"
+  deletion_window_in_days = 7
+
+  tags {
+    Name = "%s"
+  }
+}
+`, rName, rName)
+}
+
+func testAccAWSKmsExternalKeyConfigUpdated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_external_key" "test" {
+  description             = "%s-updated"
+  deletion_window_in_days = 7
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Id": "kms-tf-1",
+  "Statement": [
+    {
+      "Sid": "Enable IAM User Permissions",
+      "Effect": "Allow",
+      "Principal": {
+        "AWS": "*"
+      },
+      "Action": "kms:*",
+      "Resource": "*"
+    }
+  ]
+}
+POLICY
+
+  tags {
+    Name = "%s"
+    Key2 = "value2"
+  }
+}
+`, rName, rName)
+}
+
+func testAccAWSKmsExternalKeyConfigKeyMaterial(rName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_kms_external_key" "test" {
+  description             = "%s"
+  deletion_window_in_days = 7
+  enabled                 = %t
+  key_material_base64     = "Wblj06fduthWggmsT0cLVoIMOkeLbc2kVfMud77i/JY="
+
+  tags {
+    Name = "%s"
+  }
+}
+`, rName, enabled, rName)
+}
+
+func testAccAWSKmsExternalKeyConfigValidTo(rName, validTo string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_external_key" "test" {
+  description             = "%s"
+  deletion_window_in_days = 7
+  key_material_base64     = "Wblj06fduthWggmsT0cLVoIMOkeLbc2kVfMud77i/JY="
+  valid_to                = "%s"
+
+  tags {
+    Name = "%s"
+  }
+}
+`, rName, validTo, rName)
+}
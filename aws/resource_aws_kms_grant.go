@@ -0,0 +1,433 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsKmsGrant() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsKmsGrantCreate,
+		Read:   resourceAwsKmsGrantRead,
+		Delete: resourceAwsKmsGrantDelete,
+		Exists: resourceAwsKmsGrantExists,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				keyId, grantId, err := resourceAwsKmsGrantParseId(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				d.Set("key_id", keyId)
+				d.Set("grant_id", grantId)
+				d.SetId(fmt.Sprintf("%s:%s", keyId, grantId))
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsKmsGrantName,
+			},
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"grantee_principal": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"operations": {
+				Type: schema.TypeSet,
+				Set:  schema.HashString,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						kms.GrantOperationCreateGrant,
+						kms.GrantOperationDecrypt,
+						kms.GrantOperationDescribeKey,
+						kms.GrantOperationEncrypt,
+						kms.GrantOperationGenerateDataKey,
+						kms.GrantOperationGenerateDataKeyWithoutPlaintext,
+						kms.GrantOperationReEncryptFrom,
+						kms.GrantOperationReEncryptTo,
+						kms.GrantOperationRetireGrant,
+					}, false),
+				},
+				Required: true,
+				ForceNew: true,
+			},
+			"constraints": {
+				Type:     schema.TypeSet,
+				Set:      resourceKmsGrantConstraintsHash,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption_context_equals": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"encryption_context_subset": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"retiring_principal": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"grant_creation_tokens": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"retire_on_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+			"grant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"grant_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsKmsGrantCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+	keyId := d.Get("key_id").(string)
+
+	input := kms.CreateGrantInput{
+		GranteePrincipal: aws.String(d.Get("grantee_principal").(string)),
+		KeyId:            aws.String(keyId),
+		Operations:       expandStringSet(d.Get("operations").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.Name = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("constraints"); ok {
+		input.Constraints = expandKmsGrantConstraints(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk("retiring_principal"); ok {
+		input.RetiringPrincipal = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("grant_creation_tokens"); ok {
+		input.GrantTokens = expandStringSet(v.(*schema.Set))
+	}
+
+	var out *kms.CreateGrantOutput
+
+	log.Printf("[DEBUG] Adding new KMS grant: %s", input)
+	// The principals referenced by a grant are subject to eventual consistency,
+	// so retry while KMS doesn't yet recognize them.
+	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+		var err error
+		out, err = conn.CreateGrant(&input)
+		if err != nil {
+			if isAWSErr(err, kms.ErrCodeNotFoundException, "") ||
+				isAWSErr(err, kms.ErrCodeInvalidArnException, "") ||
+				isAWSErr(err, kms.ErrCodeDependencyTimeoutException, "") ||
+				isAWSErr(err, kms.ErrCodeInternalException, "") {
+				log.Printf("[DEBUG] Retrying KMS grant creation: %s", err)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating KMS grant: %s", err)
+	}
+
+	log.Printf("[DEBUG] Created new KMS grant: %s", out)
+	d.SetId(fmt.Sprintf("%s:%s", keyId, aws.StringValue(out.GrantId)))
+	d.Set("grant_id", out.GrantId)
+	d.Set("grant_token", out.GrantToken)
+
+	return resourceAwsKmsGrantRead(d, meta)
+}
+
+func resourceAwsKmsGrantRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	keyId, grantId, err := resourceAwsKmsGrantParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var grant *kms.GrantListEntry
+	if d.IsNewResource() {
+		grant, err = findKmsGrantByIdWithRetry(conn, keyId, grantId)
+	} else {
+		grant, err = findKmsGrantById(conn, keyId, grantId, nil)
+		if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+			grant, err = nil, nil
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("Error reading KMS grant (%s): %s", d.Id(), err)
+	}
+	if grant == nil {
+		log.Printf("[WARN] KMS grant (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// The grant sometimes contains principals identified by their unique ID, e.g. "AROAJYCVIVUZIMTXXXXX",
+	// instead of "arn:aws:...". In that case the configured value is kept.
+	if strings.HasPrefix(aws.StringValue(grant.GranteePrincipal), "arn:aws") {
+		d.Set("grantee_principal", grant.GranteePrincipal)
+	} else {
+		log.Printf("[WARN] Unable to update grantee principal state %s for grant id %s for key id %s.",
+			aws.StringValue(grant.GranteePrincipal), grantId, keyId)
+	}
+
+	if grant.RetiringPrincipal != nil {
+		if strings.HasPrefix(aws.StringValue(grant.RetiringPrincipal), "arn:aws") {
+			d.Set("retiring_principal", grant.RetiringPrincipal)
+		} else {
+			log.Printf("[WARN] Unable to update retiring principal state %s for grant id %s for key id %s",
+				aws.StringValue(grant.RetiringPrincipal), grantId, keyId)
+		}
+	}
+
+	if err := d.Set("operations", aws.StringValueSlice(grant.Operations)); err != nil {
+		return err
+	}
+	if aws.StringValue(grant.Name) != "" {
+		d.Set("name", grant.Name)
+	}
+	if grant.Constraints != nil {
+		if err := d.Set("constraints", flattenKmsGrantConstraints(grant.Constraints)); err != nil {
+			return err
+		}
+	}
+
+	d.Set("key_id", keyId)
+	d.Set("grant_id", grantId)
+
+	return nil
+}
+
+func resourceAwsKmsGrantDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	keyId, grantId, err := resourceAwsKmsGrantParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.Get("retire_on_delete").(bool) {
+		log.Printf("[DEBUG] Retiring KMS grant: %s", d.Id())
+		_, err = conn.RetireGrant(&kms.RetireGrantInput{
+			GrantId: aws.String(grantId),
+			KeyId:   aws.String(keyId),
+		})
+	} else {
+		log.Printf("[DEBUG] Revoking KMS grant: %s", d.Id())
+		_, err = conn.RevokeGrant(&kms.RevokeGrantInput{
+			GrantId: aws.String(grantId),
+			KeyId:   aws.String(keyId),
+		})
+	}
+
+	if err != nil {
+		if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting KMS grant (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Checking if KMS grant (%s) is deleted", d.Id())
+	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+		grant, err := findKmsGrantById(conn, keyId, grantId, nil)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if grant != nil {
+			return resource.RetryableError(fmt.Errorf("KMS grant (%s) still exists", d.Id()))
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error waiting for KMS grant (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsKmsGrantExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn := meta.(*AWSClient).kmsconn
+
+	keyId, grantId, err := resourceAwsKmsGrantParseId(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[DEBUG] Looking for KMS grant: %s", d.Id())
+	grant, err := findKmsGrantById(conn, keyId, grantId, nil)
+	if err != nil {
+		if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return grant != nil, nil
+}
+
+// findKmsGrantByIdWithRetry retries the lookup of a newly created grant,
+// as KMS is eventually consistent and may not list it immediately.
+func findKmsGrantByIdWithRetry(conn *kms.KMS, keyId, grantId string) (*kms.GrantListEntry, error) {
+	var grant *kms.GrantListEntry
+	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+		var err error
+		grant, err = findKmsGrantById(conn, keyId, grantId, nil)
+		if err != nil {
+			if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		if grant == nil {
+			return resource.RetryableError(fmt.Errorf("KMS grant (%s:%s) not found", keyId, grantId))
+		}
+		return nil
+	})
+
+	return grant, err
+}
+
+// findKmsGrantById pages through the grants of a key looking for the given grant.
+// It returns nil if the grant does not exist.
+func findKmsGrantById(conn *kms.KMS, keyId, grantId string, marker *string) (*kms.GrantListEntry, error) {
+	input := &kms.ListGrantsInput{
+		KeyId:  aws.String(keyId),
+		Limit:  aws.Int64(100),
+		Marker: marker,
+	}
+
+	out, err := conn.ListGrants(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, grant := range out.Grants {
+		if aws.StringValue(grant.GrantId) == grantId {
+			return grant, nil
+		}
+	}
+
+	if aws.BoolValue(out.Truncated) {
+		return findKmsGrantById(conn, keyId, grantId, out.NextMarker)
+	}
+
+	return nil, nil
+}
+
+// resourceKmsGrantConstraintsHash hashes constraints by their encryption
+// context type and sorted key/value pairs.
+func resourceKmsGrantConstraintsHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+
+	for _, k := range []string{"encryption_context_equals", "encryption_context_subset"} {
+		if ec, ok := m[k].(map[string]interface{}); ok && len(ec) > 0 {
+			buf.WriteString(fmt.Sprintf("%s-", k))
+			keys := make([]string, 0, len(ec))
+			for key := range ec {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				buf.WriteString(fmt.Sprintf("%s-%s-", key, ec[key].(string)))
+			}
+		}
+	}
+
+	return hashcode.String(buf.String())
+}
+
+func expandKmsGrantConstraints(configured *schema.Set) *kms.GrantConstraints {
+	if len(configured.List()) < 1 {
+		return nil
+	}
+
+	var constraint kms.GrantConstraints
+
+	for _, raw := range configured.List() {
+		data := raw.(map[string]interface{})
+		if contextEq, ok := data["encryption_context_equals"]; ok && len(contextEq.(map[string]interface{})) > 0 {
+			constraint.SetEncryptionContextEquals(stringMapToPointers(contextEq.(map[string]interface{})))
+		}
+		if contextSub, ok := data["encryption_context_subset"]; ok && len(contextSub.(map[string]interface{})) > 0 {
+			constraint.SetEncryptionContextSubset(stringMapToPointers(contextSub.(map[string]interface{})))
+		}
+	}
+
+	return &constraint
+}
+
+func flattenKmsGrantConstraints(constraint *kms.GrantConstraints) *schema.Set {
+	constraints := schema.NewSet(resourceKmsGrantConstraintsHash, []interface{}{})
+	if constraint == nil {
+		return constraints
+	}
+
+	m := make(map[string]interface{})
+	if constraint.EncryptionContextEquals != nil {
+		m["encryption_context_equals"] = pointersMapToStringList(constraint.EncryptionContextEquals)
+	}
+	if constraint.EncryptionContextSubset != nil {
+		m["encryption_context_subset"] = pointersMapToStringList(constraint.EncryptionContextSubset)
+	}
+	if len(m) > 0 {
+		constraints.Add(m)
+	}
+
+	return constraints
+}
+
+// resourceAwsKmsGrantParseId splits a grant resource ID of the form KEY_ID:GRANT_ID.
+// The key ID may itself be an ARN, so the grant ID is taken after the last colon.
+func resourceAwsKmsGrantParseId(id string) (string, string, error) {
+	idx := strings.LastIndex(id, ":")
+	if idx < 1 || idx == len(id)-1 {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected KeyID:GrantID", id)
+	}
+
+	return id[:idx], id[idx+1:], nil
+}
@@ -0,0 +1,386 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSKmsGrant_basic(t *testing.T) {
+	timestamp := time.Now().Format(time.RFC1123)
+	rName := acctest.RandomWithPrefix("tf-acc-test-kms-grant")
+	resourceName := "aws_kms_grant.basic"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsGrantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsGrant_Basic(rName, timestamp, "\"Encrypt\", \"Decrypt\""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsGrantExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "operations.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "operations.2238845196", "Encrypt"),
+					resource.TestCheckResourceAttr(resourceName, "operations.1237510779", "Decrypt"),
+					resource.TestCheckResourceAttrSet(resourceName, "grantee_principal"),
+					resource.TestCheckResourceAttrSet(resourceName, "key_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "grant_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "grant_token"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSKmsGrant_withConstraints(t *testing.T) {
+	timestamp := time.Now().Format(time.RFC1123)
+	rName := acctest.RandomWithPrefix("tf-acc-test-kms-grant")
+	resourceName := "aws_kms_grant.constraints"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsGrantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsGrant_withConstraints(rName, timestamp, "encryption_context_equals", `foo = "bar"
+			baz = "kaz"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsGrantExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "constraints.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "constraints.449762259.encryption_context_equals.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "constraints.449762259.encryption_context_equals.baz", "kaz"),
+					resource.TestCheckResourceAttr(resourceName, "constraints.449762259.encryption_context_equals.foo", "bar"),
+				),
+			},
+			{
+				Config: testAccAWSKmsGrant_withConstraints(rName, timestamp, "encryption_context_subset", `foo = "bar"
+			baz = "kaz"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsGrantExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "constraints.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "constraints.2645649985.encryption_context_subset.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "constraints.2645649985.encryption_context_subset.baz", "kaz"),
+					resource.TestCheckResourceAttr(resourceName, "constraints.2645649985.encryption_context_subset.foo", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSKmsGrant_withRetiringPrincipal(t *testing.T) {
+	timestamp := time.Now().Format(time.RFC1123)
+	rName := acctest.RandomWithPrefix("tf-acc-test-kms-grant")
+	resourceName := "aws_kms_grant.retiring"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsGrantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsGrant_withRetiringPrincipal(rName, timestamp),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsGrantExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "retiring_principal"),
+					resource.TestCheckResourceAttr(resourceName, "retire_on_delete", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSKmsGrant_bare(t *testing.T) {
+	timestamp := time.Now().Format(time.RFC1123)
+	rName := acctest.RandomWithPrefix("tf-acc-test-kms-grant")
+	resourceName := "aws_kms_grant.bare"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsGrantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsGrant_bare(rName, timestamp),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsGrantExists(resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "name"),
+					resource.TestCheckNoResourceAttr(resourceName, "constraints.#"),
+					resource.TestCheckNoResourceAttr(resourceName, "retiring_principal"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSKmsGrant_import(t *testing.T) {
+	timestamp := time.Now().Format(time.RFC1123)
+	rName := acctest.RandomWithPrefix("tf-acc-test-kms-grant")
+	resourceName := "aws_kms_grant.basic"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsGrantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsGrant_Basic(rName, timestamp, "\"Encrypt\", \"Decrypt\""),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"grant_token", "retire_on_delete"},
+			},
+		},
+	})
+}
+
+func TestResourceAwsKmsGrantParseId(t *testing.T) {
+	cases := []struct {
+		Id       string
+		KeyId    string
+		GrantId  string
+		ErrCount int
+	}{
+		{
+			Id:      "1234abcd-12ab-34cd-56ef-1234567890ab:abcde1237f76e4ba7987489ac329fbfba6ad343d6f7075dbd1ef191f0120514",
+			KeyId:   "1234abcd-12ab-34cd-56ef-1234567890ab",
+			GrantId: "abcde1237f76e4ba7987489ac329fbfba6ad343d6f7075dbd1ef191f0120514",
+		},
+		{
+			Id:      "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab:abcde123",
+			KeyId:   "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			GrantId: "abcde123",
+		},
+		{
+			Id:       "1234abcd-12ab-34cd-56ef-1234567890ab",
+			ErrCount: 1,
+		},
+		{
+			Id:       "1234abcd-12ab-34cd-56ef-1234567890ab:",
+			ErrCount: 1,
+		},
+		{
+			Id:       ":abcde123",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		keyId, grantId, err := resourceAwsKmsGrantParseId(tc.Id)
+		if tc.ErrCount > 0 {
+			if err == nil {
+				t.Fatalf("expected error for ID %q", tc.Id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error for ID %q: %s", tc.Id, err)
+		}
+		if keyId != tc.KeyId || grantId != tc.GrantId {
+			t.Fatalf("ID %q: expected (%q, %q), got (%q, %q)", tc.Id, tc.KeyId, tc.GrantId, keyId, grantId)
+		}
+	}
+}
+
+func TestKmsGrantConstraints(t *testing.T) {
+	constraints := schema.NewSet(resourceKmsGrantConstraintsHash, []interface{}{
+		map[string]interface{}{
+			"encryption_context_equals": map[string]interface{}{
+				"foo": "bar",
+				"baz": "kaz",
+			},
+			"encryption_context_subset": map[string]interface{}{},
+		},
+	})
+
+	expanded := expandKmsGrantConstraints(constraints)
+	if expanded == nil {
+		t.Fatal("expected constraints, got nil")
+	}
+	if len(expanded.EncryptionContextEquals) != 2 || aws.StringValue(expanded.EncryptionContextEquals["foo"]) != "bar" {
+		t.Fatalf("unexpected encryption_context_equals: %#v", expanded.EncryptionContextEquals)
+	}
+	if expanded.EncryptionContextSubset != nil {
+		t.Fatalf("expected no encryption_context_subset, got: %#v", expanded.EncryptionContextSubset)
+	}
+
+	flattened := flattenKmsGrantConstraints(expanded)
+	if flattened.Len() != 1 {
+		t.Fatalf("expected 1 flattened constraint, got %d", flattened.Len())
+	}
+	if !flattened.Contains(constraints.List()[0]) {
+		t.Fatalf("flattened constraints %#v don't match %#v", flattened.List(), constraints.List())
+	}
+
+	if expandKmsGrantConstraints(schema.NewSet(resourceKmsGrantConstraintsHash, nil)) != nil {
+		t.Fatal("expected nil constraints for an empty set")
+	}
+}
+
+func testAccCheckAWSKmsGrantDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).kmsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kms_grant" {
+			continue
+		}
+
+		keyId, grantId, err := resourceAwsKmsGrantParseId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		grant, err := findKmsGrantById(conn, keyId, grantId, nil)
+		if err != nil {
+			if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+		if grant != nil {
+			return fmt.Errorf("KMS grant (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSKmsGrantExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No KMS grant ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kmsconn
+		keyId, grantId, err := resourceAwsKmsGrantParseId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		grant, err := findKmsGrantByIdWithRetry(conn, keyId, grantId)
+		if err != nil {
+			return err
+		}
+		if grant == nil {
+			return fmt.Errorf("KMS grant (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSKmsGrantConfigBase(timestamp string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "tf-acc-test-key" {
+    description = "Terraform acc test key %s"
+    deletion_window_in_days = 7
+}
+
+%s
+`, timestamp, staticAssumeRolePolicyString)
+}
+
+func testAccAWSKmsGrant_Basic(rName, timestamp, operations string) string {
+	return testAccAWSKmsGrantConfigBase(timestamp) + fmt.Sprintf(`
+resource "aws_iam_role" "tf-acc-test-role" {
+  name               = "%s"
+  path               = "/service-role/"
+  assume_role_policy = "${data.aws_iam_policy_document.assumerole-policy-template.json}"
+}
+
+resource "aws_kms_grant" "basic" {
+	name = "%s"
+	key_id = "${aws_kms_key.tf-acc-test-key.key_id}"
+	grantee_principal = "${aws_iam_role.tf-acc-test-role.arn}"
+	operations = [ %s ]
+}
+`, rName, rName, operations)
+}
+
+func testAccAWSKmsGrant_withConstraints(rName, timestamp, constraintName, encryptionContext string) string {
+	return testAccAWSKmsGrantConfigBase(timestamp) + fmt.Sprintf(`
+resource "aws_iam_role" "tf-acc-test-role" {
+  name               = "%s"
+  path               = "/service-role/"
+  assume_role_policy = "${data.aws_iam_policy_document.assumerole-policy-template.json}"
+}
+
+resource "aws_kms_grant" "constraints" {
+	name = "%s"
+	key_id = "${aws_kms_key.tf-acc-test-key.key_id}"
+	grantee_principal = "${aws_iam_role.tf-acc-test-role.arn}"
+	operations = [ "RetireGrant", "DescribeKey" ]
+	constraints {
+		%s {
+			%s
+		}
+	}
+}
+`, rName, rName, constraintName, encryptionContext)
+}
+
+func testAccAWSKmsGrant_withRetiringPrincipal(rName, timestamp string) string {
+	return testAccAWSKmsGrantConfigBase(timestamp) + fmt.Sprintf(`
+resource "aws_iam_role" "tf-acc-test-role" {
+  name               = "%s"
+  path               = "/service-role/"
+  assume_role_policy = "${data.aws_iam_policy_document.assumerole-policy-template.json}"
+}
+
+resource "aws_kms_grant" "retiring" {
+	name = "%s"
+	key_id = "${aws_kms_key.tf-acc-test-key.key_id}"
+	grantee_principal = "${aws_iam_role.tf-acc-test-role.arn}"
+	operations = ["ReEncryptTo", "CreateGrant"]
+	retiring_principal = "${aws_iam_role.tf-acc-test-role.arn}"
+	retire_on_delete = true
+}
+`, rName, rName)
+}
+
+func testAccAWSKmsGrant_bare(rName, timestamp string) string {
+	return testAccAWSKmsGrantConfigBase(timestamp) + fmt.Sprintf(`
+resource "aws_iam_role" "tf-acc-test-role" {
+  name               = "%s"
+  path               = "/service-role/"
+  assume_role_policy = "${data.aws_iam_policy_document.assumerole-policy-template.json}"
+}
+
+resource "aws_kms_grant" "bare" {
+	key_id = "${aws_kms_key.tf-acc-test-key.key_id}"
+	grantee_principal = "${aws_iam_role.tf-acc-test-role.arn}"
+	operations = ["ReEncryptTo", "CreateGrant"]
+}
+`, rName)
+}
+
+const staticAssumeRolePolicyString = `
+data "aws_iam_policy_document" "assumerole-policy-template" {
+  statement {
+    effect  = "Allow"
+    actions = [ "sts:AssumeRole" ]
+    principals {
+      type        = "Service"
+      identifiers = [ "ec2.amazonaws.com" ]
+    }
+  }
+}
+`
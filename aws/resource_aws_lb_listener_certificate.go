@@ -0,0 +1,166 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsLbListenerCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLbListenerCertificateCreate,
+		Read:   resourceAwsLbListenerCertificateRead,
+		Delete: resourceAwsLbListenerCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"listener_arn": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"certificate_arn": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLbListenerCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elbv2conn
+
+	listenerArn := d.Get("listener_arn").(string)
+	certificateArn := d.Get("certificate_arn").(string)
+
+	params := &elbv2.AddListenerCertificatesInput{
+		ListenerArn: aws.String(listenerArn),
+		Certificates: []*elbv2.Certificate{
+			{
+				CertificateArn: aws.String(certificateArn),
+			},
+		},
+	}
+
+	log.Printf("[DEBUG] Adding certificate to LB listener: %s", params)
+	_, err := conn.AddListenerCertificates(params)
+	if err != nil {
+		return fmt.Errorf("Error adding certificate %s to LB listener %s: %s", certificateArn, listenerArn, err)
+	}
+
+	d.SetId(listenerArn + "_" + certificateArn)
+
+	return resourceAwsLbListenerCertificateRead(d, meta)
+}
+
+func resourceAwsLbListenerCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elbv2conn
+
+	listenerArn, certificateArn, err := decodeLbListenerCertificateID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	certificate, err := findAwsLbListenerCertificate(conn, listenerArn, certificateArn)
+	if err != nil {
+		if isAWSErr(err, elbv2.ErrCodeListenerNotFoundException, "") {
+			log.Printf("[WARN] LB listener (%s) not found, removing certificate %s from state", listenerArn, certificateArn)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading certificates of LB listener %s: %s", listenerArn, err)
+	}
+
+	if certificate == nil {
+		log.Printf("[WARN] LB listener certificate (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("listener_arn", listenerArn)
+	d.Set("certificate_arn", certificateArn)
+
+	return nil
+}
+
+func resourceAwsLbListenerCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elbv2conn
+
+	listenerArn, certificateArn, err := decodeLbListenerCertificateID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Removing certificate %s from LB listener %s", certificateArn, listenerArn)
+	_, err = conn.RemoveListenerCertificates(&elbv2.RemoveListenerCertificatesInput{
+		ListenerArn: aws.String(listenerArn),
+		Certificates: []*elbv2.Certificate{
+			{
+				CertificateArn: aws.String(certificateArn),
+			},
+		},
+	})
+	if err != nil {
+		if isAWSErr(err, elbv2.ErrCodeCertificateNotFoundException, "") {
+			return nil
+		}
+		if isAWSErr(err, elbv2.ErrCodeListenerNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error removing certificate %s from LB listener %s: %s", certificateArn, listenerArn, err)
+	}
+
+	return nil
+}
+
+// findAwsLbListenerCertificate returns the given non-default certificate of
+// the listener, or nil if it is not attached.
+func findAwsLbListenerCertificate(conn *elbv2.ELBV2, listenerArn, certificateArn string) (*elbv2.Certificate, error) {
+	params := &elbv2.DescribeListenerCertificatesInput{
+		ListenerArn: aws.String(listenerArn),
+		PageSize:    aws.Int64(400),
+	}
+
+	for {
+		resp, err := conn.DescribeListenerCertificates(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, cert := range resp.Certificates {
+			// The listener's default certificate is managed by aws_lb_listener
+			if aws.BoolValue(cert.IsDefault) {
+				continue
+			}
+
+			if aws.StringValue(cert.CertificateArn) == certificateArn {
+				return cert, nil
+			}
+		}
+
+		if resp.NextMarker == nil {
+			break
+		}
+		params.Marker = resp.NextMarker
+	}
+
+	return nil, nil
+}
+
+// Certificate ARNs may themselves contain underscores, while listener ARNs
+// never do, so the ID is split on the first one.
+func decodeLbListenerCertificateID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "_", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected listener_arn_certificate_arn", id)
+	}
+
+	return parts[0], parts[1], nil
+}
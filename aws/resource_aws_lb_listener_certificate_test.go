@@ -0,0 +1,245 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsLbListenerCertificate_basic(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersWithTLS,
+		CheckDestroy: testAccCheckAwsLbListenerCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLbListenerCertificateConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLbListenerCertificateExists("aws_lb_listener_certificate.test.0"),
+					resource.TestCheckResourceAttrPair("aws_lb_listener_certificate.test.0", "listener_arn", "aws_lb_listener.test", "arn"),
+					resource.TestCheckResourceAttrPair("aws_lb_listener_certificate.test.0", "certificate_arn", "aws_iam_server_certificate.additional.0", "arn"),
+				),
+			},
+			{
+				Config: testAccLbListenerCertificateConfig(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLbListenerCertificateExists("aws_lb_listener_certificate.test.0"),
+					testAccCheckAwsLbListenerCertificateExists("aws_lb_listener_certificate.test.1"),
+					testAccCheckAwsLbListenerCertificateExists("aws_lb_listener_certificate.test.2"),
+				),
+			},
+			{
+				ResourceName:      "aws_lb_listener_certificate.test.0",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestDecodeLbListenerCertificateID(t *testing.T) {
+	cases := []struct {
+		ID             string
+		ListenerArn    string
+		CertificateArn string
+		ErrCount       int
+	}{
+		{
+			ID:             "arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/test/8e4497da625e2d8a/9ab28ade35828f96_arn:aws:iam::123456789012:server-certificate/test_cert",
+			ListenerArn:    "arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/test/8e4497da625e2d8a/9ab28ade35828f96",
+			CertificateArn: "arn:aws:iam::123456789012:server-certificate/test_cert",
+		},
+		{
+			ID:       "arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/test/8e4497da625e2d8a/9ab28ade35828f96",
+			ErrCount: 1,
+		},
+		{
+			ID:       "_arn:aws:iam::123456789012:server-certificate/test",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		listenerArn, certificateArn, err := decodeLbListenerCertificateID(tc.ID)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.ID, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.ID)
+		}
+		if listenerArn != tc.ListenerArn {
+			t.Fatalf("expected listener ARN %q, received %q", tc.ListenerArn, listenerArn)
+		}
+		if certificateArn != tc.CertificateArn {
+			t.Fatalf("expected certificate ARN %q, received %q", tc.CertificateArn, certificateArn)
+		}
+	}
+}
+
+func testAccCheckAwsLbListenerCertificateDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lb_listener_certificate" {
+			continue
+		}
+
+		certificate, err := findAwsLbListenerCertificate(conn, rs.Primary.Attributes["listener_arn"], rs.Primary.Attributes["certificate_arn"])
+		if err != nil {
+			if isAWSErr(err, elbv2.ErrCodeListenerNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		if certificate != nil {
+			return fmt.Errorf("LB listener certificate %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsLbListenerCertificateExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No LB listener certificate ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+
+		certificate, err := findAwsLbListenerCertificate(conn, rs.Primary.Attributes["listener_arn"], rs.Primary.Attributes["certificate_arn"])
+		if err != nil {
+			return err
+		}
+
+		if certificate == nil {
+			return fmt.Errorf("LB listener certificate %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccLbListenerCertificateConfig(rName string, count int) string {
+	return fmt.Sprintf(`
+resource "aws_lb_listener_certificate" "test" {
+  count           = %[2]d
+  listener_arn    = "${aws_lb_listener.test.arn}"
+  certificate_arn = "${element(aws_iam_server_certificate.additional.*.arn, count.index)}"
+}
+
+resource "aws_lb" "test" {
+  name            = "tf-lb-%[1]s"
+  internal        = true
+  security_groups = ["${aws_security_group.test.id}"]
+  subnets         = ["${aws_subnet.test.*.id}"]
+}
+
+resource "aws_lb_target_group" "test" {
+  port     = 443
+  protocol = "HTTP"
+  vpc_id   = "${aws_vpc.test.id}"
+}
+
+resource "aws_lb_listener" "test" {
+  load_balancer_arn = "${aws_lb.test.arn}"
+  protocol          = "HTTPS"
+  port              = "443"
+  ssl_policy        = "ELBSecurityPolicy-2016-08"
+  certificate_arn   = "${aws_iam_server_certificate.default.arn}"
+
+  default_action {
+    target_group_arn = "${aws_lb_target_group.test.arn}"
+    type             = "forward"
+  }
+}
+
+data "aws_availability_zones" "available" {}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-lb-listener-certificate"
+  }
+}
+
+resource "aws_subnet" "test" {
+  count             = 2
+  vpc_id            = "${aws_vpc.test.id}"
+  cidr_block        = "10.0.${count.index}.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[count.index]}"
+}
+
+resource "aws_security_group" "test" {
+  name   = "tf-lb-%[1]s"
+  vpc_id = "${aws_vpc.test.id}"
+}
+
+resource "tls_private_key" "test" {
+  algorithm = "RSA"
+}
+
+resource "tls_self_signed_cert" "default" {
+  key_algorithm   = "RSA"
+  private_key_pem = "${tls_private_key.test.private_key_pem}"
+
+  subject {
+    common_name  = "default.example.com"
+    organization = "ACME Examples, Inc"
+  }
+
+  validity_period_hours = 12
+
+  allowed_uses = [
+    "key_encipherment",
+    "digital_signature",
+    "server_auth",
+  ]
+}
+
+resource "aws_iam_server_certificate" "default" {
+  name             = "tf-lb-default-%[1]s"
+  certificate_body = "${tls_self_signed_cert.default.cert_pem}"
+  private_key      = "${tls_private_key.test.private_key_pem}"
+}
+
+resource "tls_self_signed_cert" "additional" {
+  count           = 3
+  key_algorithm   = "RSA"
+  private_key_pem = "${tls_private_key.test.private_key_pem}"
+
+  subject {
+    common_name  = "additional-${count.index}.example.com"
+    organization = "ACME Examples, Inc"
+  }
+
+  validity_period_hours = 12
+
+  allowed_uses = [
+    "key_encipherment",
+    "digital_signature",
+    "server_auth",
+  ]
+}
+
+resource "aws_iam_server_certificate" "additional" {
+  count            = 3
+  name             = "tf-lb-additional-${count.index}-%[1]s"
+  certificate_body = "${element(tls_self_signed_cert.additional.*.cert_pem, count.index)}"
+  private_key      = "${tls_private_key.test.private_key_pem}"
+}
+`, rName, count)
+}
@@ -0,0 +1,128 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLightsailDisk() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLightsailDiskCreate,
+		Read:   resourceAwsLightsailDiskRead,
+		Delete: resourceAwsLightsailDiskDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"size_in_gb": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(8, 16384),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"support_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLightsailDiskCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Creating Lightsail Disk: %q", name)
+	resp, err := conn.CreateDisk(&lightsail.CreateDiskInput{
+		AvailabilityZone: aws.String(d.Get("availability_zone").(string)),
+		DiskName:         aws.String(name),
+		SizeInGb:         aws.Int64(int64(d.Get("size_in_gb").(int))),
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Operations) == 0 {
+		return fmt.Errorf("[ERR] No operations found for CreateDisk request")
+	}
+
+	d.SetId(name)
+
+	if err := waitForLightsailOperation(resp.Operations[0].Id, meta); err != nil {
+		return fmt.Errorf("Error waiting for Lightsail Disk (%s) to become ready: %s", d.Id(), err)
+	}
+
+	return resourceAwsLightsailDiskRead(d, meta)
+}
+
+func resourceAwsLightsailDiskRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+	resp, err := conn.GetDisk(&lightsail.GetDiskInput{
+		DiskName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Lightsail Disk (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	disk := resp.Disk
+
+	d.Set("name", disk.Name)
+	d.Set("availability_zone", disk.Location.AvailabilityZone)
+	d.Set("size_in_gb", disk.SizeInGb)
+	d.Set("arn", disk.Arn)
+	d.Set("created_at", disk.CreatedAt.Format(time.RFC3339))
+	d.Set("support_code", disk.SupportCode)
+
+	return nil
+}
+
+func resourceAwsLightsailDiskDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+	resp, err := conn.DeleteDisk(&lightsail.DeleteDiskInput{
+		DiskName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return err
+	}
+
+	if len(resp.Operations) > 0 {
+		if err := waitForLightsailOperation(resp.Operations[0].Id, meta); err != nil {
+			return fmt.Errorf("Error waiting for Lightsail Disk (%s) to become destroyed: %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsLightsailDiskAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLightsailDiskAttachmentCreate,
+		Read:   resourceAwsLightsailDiskAttachmentRead,
+		Delete: resourceAwsLightsailDiskAttachmentDelete,
+
+		Schema: map[string]*schema.Schema{
+			"disk_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"disk_path": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLightsailDiskAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	diskName := d.Get("disk_name").(string)
+	log.Printf("[INFO] Attaching Lightsail Disk: %q", diskName)
+	resp, err := conn.AttachDisk(&lightsail.AttachDiskInput{
+		DiskName:     aws.String(diskName),
+		DiskPath:     aws.String(d.Get("disk_path").(string)),
+		InstanceName: aws.String(d.Get("instance_name").(string)),
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(diskName)
+
+	if len(resp.Operations) > 0 {
+		if err := waitForLightsailOperation(resp.Operations[0].Id, meta); err != nil {
+			return fmt.Errorf("Error waiting for Lightsail Disk (%s) to be attached: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsLightsailDiskAttachmentRead(d, meta)
+}
+
+func resourceAwsLightsailDiskAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	resp, err := conn.GetDisk(&lightsail.GetDiskInput{
+		DiskName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Lightsail Disk (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	if !aws.BoolValue(resp.Disk.IsAttached) {
+		log.Printf("[WARN] Lightsail Disk (%s) is not attached, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("disk_name", resp.Disk.Name)
+	d.Set("instance_name", resp.Disk.AttachedTo)
+	d.Set("disk_path", resp.Disk.Path)
+
+	return nil
+}
+
+func resourceAwsLightsailDiskAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	// Lightsail only detaches disks from stopped instances, so a running
+	// instance is stopped for the detach and started again afterwards.
+	instanceName := d.Get("instance_name").(string)
+	stateResp, err := conn.GetInstanceState(&lightsail.GetInstanceStateInput{
+		InstanceName: aws.String(instanceName),
+	})
+	if err != nil && !isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+		return fmt.Errorf("Error reading Lightsail Instance (%s) state: %s", instanceName, err)
+	}
+	running := err == nil && stateResp.State != nil && aws.StringValue(stateResp.State.Name) == "running"
+
+	if running {
+		log.Printf("[INFO] Stopping Lightsail Instance: %q", instanceName)
+		stopResp, err := conn.StopInstance(&lightsail.StopInstanceInput{
+			InstanceName: aws.String(instanceName),
+		})
+		if err != nil {
+			return fmt.Errorf("Error stopping Lightsail Instance (%s): %s", instanceName, err)
+		}
+		if len(stopResp.Operations) > 0 {
+			if err := waitForLightsailOperation(stopResp.Operations[0].Id, meta); err != nil {
+				return fmt.Errorf("Error waiting for Lightsail Instance (%s) to stop: %s", instanceName, err)
+			}
+		}
+	}
+
+	log.Printf("[INFO] Detaching Lightsail Disk: %q", d.Id())
+	resp, err := conn.DetachDisk(&lightsail.DetachDiskInput{
+		DiskName: aws.String(d.Id()),
+	})
+	if err != nil && !isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+		return fmt.Errorf("Error detaching Lightsail Disk (%s): %s", d.Id(), err)
+	}
+
+	if err == nil && len(resp.Operations) > 0 {
+		if err := waitForLightsailOperation(resp.Operations[0].Id, meta); err != nil {
+			return fmt.Errorf("Error waiting for Lightsail Disk (%s) to be detached: %s", d.Id(), err)
+		}
+	}
+
+	if running {
+		log.Printf("[INFO] Starting Lightsail Instance: %q", instanceName)
+		startResp, err := conn.StartInstance(&lightsail.StartInstanceInput{
+			InstanceName: aws.String(instanceName),
+		})
+		if err != nil {
+			return fmt.Errorf("Error starting Lightsail Instance (%s): %s", instanceName, err)
+		}
+		if len(startResp.Operations) > 0 {
+			if err := waitForLightsailOperation(startResp.Operations[0].Id, meta); err != nil {
+				return fmt.Errorf("Error waiting for Lightsail Instance (%s) to start: %s", instanceName, err)
+			}
+		}
+	}
+
+	return nil
+}
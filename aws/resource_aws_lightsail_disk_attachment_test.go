@@ -0,0 +1,114 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLightsailDiskAttachment_basic(t *testing.T) {
+	diskName := fmt.Sprintf("tf-test-lightsail-%s", acctest.RandString(5))
+	instanceName := fmt.Sprintf("tf-test-lightsail-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailDiskAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailDiskAttachmentConfig_basic(diskName, instanceName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailDiskAttachmentExists("aws_lightsail_disk_attachment.test"),
+					resource.TestCheckResourceAttr("aws_lightsail_disk_attachment.test", "disk_path", "/dev/xvdf"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLightsailDiskAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Lightsail Disk Attachment ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+		resp, err := conn.GetDisk(&lightsail.GetDiskInput{
+			DiskName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if !aws.BoolValue(resp.Disk.IsAttached) {
+			return fmt.Errorf("Disk (%s) not attached", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSLightsailDiskAttachmentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_disk_attachment" {
+			continue
+		}
+
+		resp, err := conn.GetDisk(&lightsail.GetDiskInput{
+			DiskName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		if aws.BoolValue(resp.Disk.IsAttached) {
+			return fmt.Errorf("Lightsail Disk %q is still attached (to %q)", rs.Primary.ID, aws.StringValue(resp.Disk.AttachedTo))
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSLightsailDiskAttachmentConfig_basic(diskName, instanceName string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_lightsail_disk" "test" {
+  name              = "%s"
+  availability_zone = "us-east-1b"
+  size_in_gb        = 8
+}
+
+resource "aws_lightsail_instance" "test" {
+  name              = "%s"
+  availability_zone = "us-east-1b"
+  blueprint_id      = "gitlab_8_12_6"
+  bundle_id         = "nano_1_0"
+}
+
+resource "aws_lightsail_disk_attachment" "test" {
+  disk_name     = "${aws_lightsail_disk.test.name}"
+  instance_name = "${aws_lightsail_instance.test.name}"
+  disk_path     = "/dev/xvdf"
+}
+`, diskName, instanceName)
+}
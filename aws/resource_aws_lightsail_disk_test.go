@@ -0,0 +1,105 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLightsailDisk_basic(t *testing.T) {
+	var disk lightsail.Disk
+	diskName := fmt.Sprintf("tf-test-lightsail-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailDiskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailDiskConfig_basic(diskName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailDiskExists("aws_lightsail_disk.test", &disk),
+					resource.TestCheckResourceAttr("aws_lightsail_disk.test", "name", diskName),
+					resource.TestCheckResourceAttr("aws_lightsail_disk.test", "size_in_gb", "8"),
+					resource.TestCheckResourceAttrSet("aws_lightsail_disk.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      "aws_lightsail_disk.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLightsailDiskExists(n string, disk *lightsail.Disk) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Lightsail Disk ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+		resp, err := conn.GetDisk(&lightsail.GetDiskInput{
+			DiskName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if resp == nil || resp.Disk == nil {
+			return fmt.Errorf("Disk (%s) not found", rs.Primary.ID)
+		}
+
+		*disk = *resp.Disk
+		return nil
+	}
+}
+
+func testAccCheckAWSLightsailDiskDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_disk" {
+			continue
+		}
+
+		_, err := conn.GetDisk(&lightsail.GetDiskInput{
+			DiskName: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("Lightsail Disk %q still exists", rs.Primary.ID)
+		}
+		if !isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSLightsailDiskConfig_basic(diskName string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_lightsail_disk" "test" {
+  name              = "%s"
+  availability_zone = "us-east-1b"
+  size_in_gb        = 8
+}
+`, diskName)
+}
@@ -0,0 +1,180 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLightsailDomainEntry() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLightsailDomainEntryCreate,
+		Read:   resourceAwsLightsailDomainEntryRead,
+		Delete: resourceAwsLightsailDomainEntryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"A",
+					"CNAME",
+					"MX",
+					"NS",
+					"SOA",
+					"SRV",
+					"TXT",
+				}, false),
+			},
+			"target": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"is_alias": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLightsailDomainEntryCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	domainName := d.Get("domain_name").(string)
+	entry := &lightsail.DomainEntry{
+		IsAlias: aws.Bool(d.Get("is_alias").(bool)),
+		Name:    aws.String(d.Get("name").(string)),
+		Target:  aws.String(d.Get("target").(string)),
+		Type:    aws.String(d.Get("type").(string)),
+	}
+
+	log.Printf("[INFO] Creating Lightsail Domain Entry: %s", entry)
+	resp, err := conn.CreateDomainEntry(&lightsail.CreateDomainEntryInput{
+		DomainEntry: entry,
+		DomainName:  aws.String(domainName),
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strings.Join([]string{
+		aws.StringValue(entry.Name),
+		domainName,
+		aws.StringValue(entry.Type),
+		aws.StringValue(entry.Target),
+	}, ","))
+
+	if resp.Operation != nil {
+		if err := waitForLightsailOperation(resp.Operation.Id, meta); err != nil {
+			return fmt.Errorf("Error waiting for Lightsail Domain Entry (%s) to become ready: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsLightsailDomainEntryRead(d, meta)
+}
+
+func resourceAwsLightsailDomainEntryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	name, domainName, recordType, target, err := decodeLightsailDomainEntryID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.GetDomain(&lightsail.GetDomainInput{
+		DomainName: aws.String(domainName),
+	})
+	if err != nil {
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Lightsail Domain (%s) not found, removing Domain Entry from state", domainName)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	var entry *lightsail.DomainEntry
+	for _, e := range resp.Domain.DomainEntries {
+		if aws.StringValue(e.Name) == name && aws.StringValue(e.Type) == recordType && aws.StringValue(e.Target) == target {
+			entry = e
+			break
+		}
+	}
+	if entry == nil {
+		log.Printf("[WARN] Lightsail Domain Entry (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("domain_name", domainName)
+	d.Set("name", entry.Name)
+	d.Set("type", entry.Type)
+	d.Set("target", entry.Target)
+	d.Set("is_alias", aws.BoolValue(entry.IsAlias))
+
+	return nil
+}
+
+func resourceAwsLightsailDomainEntryDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	name, domainName, recordType, target, err := decodeLightsailDomainEntryID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.DeleteDomainEntry(&lightsail.DeleteDomainEntryInput{
+		DomainEntry: &lightsail.DomainEntry{
+			IsAlias: aws.Bool(d.Get("is_alias").(bool)),
+			Name:    aws.String(name),
+			Target:  aws.String(target),
+			Type:    aws.String(recordType),
+		},
+		DomainName: aws.String(domainName),
+	})
+	if err != nil {
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return err
+	}
+
+	if resp.Operation != nil {
+		if err := waitForLightsailOperation(resp.Operation.Id, meta); err != nil {
+			return fmt.Errorf("Error waiting for Lightsail Domain Entry (%s) to become destroyed: %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func decodeLightsailDomainEntryID(id string) (string, string, string, string, error) {
+	parts := strings.SplitN(id, ",", 4)
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		return "", "", "", "", fmt.Errorf("Lightsail Domain Entry ID must be of the form <name>,<domain_name>,<type>,<target>, was provided: %s", id)
+	}
+	return parts[0], parts[1], parts[2], parts[3], nil
+}
@@ -0,0 +1,179 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDecodeLightsailDomainEntryID(t *testing.T) {
+	var testCases = []struct {
+		Input      string
+		Name       string
+		DomainName string
+		Type       string
+		Target     string
+		ErrCount   int
+	}{
+		{
+			Input:    "",
+			ErrCount: 1,
+		},
+		{
+			Input:    "www.example.com,example.com,A",
+			ErrCount: 1,
+		},
+		{
+			Input:    "www.example.com,,A,127.0.0.1",
+			ErrCount: 1,
+		},
+		{
+			Input:      "www.example.com,example.com,A,127.0.0.1",
+			Name:       "www.example.com",
+			DomainName: "example.com",
+			Type:       "A",
+			Target:     "127.0.0.1",
+			ErrCount:   0,
+		},
+		{
+			Input:      "example.com,example.com,TXT,\"v=spf1 a,mx ~all\"",
+			Name:       "example.com",
+			DomainName: "example.com",
+			Type:       "TXT",
+			Target:     "\"v=spf1 a,mx ~all\"",
+			ErrCount:   0,
+		},
+	}
+
+	for _, tc := range testCases {
+		name, domainName, recordType, target, err := decodeLightsailDomainEntryID(tc.Input)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.Input, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.Input)
+		}
+		if name != tc.Name || domainName != tc.DomainName || recordType != tc.Type || target != tc.Target {
+			t.Fatalf("expected %q to decode to (%q, %q, %q, %q), received: (%q, %q, %q, %q)",
+				tc.Input, tc.Name, tc.DomainName, tc.Type, tc.Target, name, domainName, recordType, target)
+		}
+	}
+}
+
+func TestAccAWSLightsailDomainEntry_basic(t *testing.T) {
+	domainName := fmt.Sprintf("tf-test-lightsail-%s.com", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailDomainEntryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailDomainEntryConfig_basic(domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailDomainEntryExists("aws_lightsail_domain_entry.test"),
+					resource.TestCheckResourceAttr("aws_lightsail_domain_entry.test", "type", "A"),
+					resource.TestCheckResourceAttr("aws_lightsail_domain_entry.test", "target", "127.0.0.1"),
+				),
+			},
+			{
+				ResourceName:      "aws_lightsail_domain_entry.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLightsailDomainEntryExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Lightsail Domain Entry ID is set")
+		}
+
+		found, err := testAccAWSLightsailDomainEntryFound(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("Domain Entry (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSLightsailDomainEntryDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_domain_entry" {
+			continue
+		}
+
+		found, err := testAccAWSLightsailDomainEntryFound(rs.Primary.ID)
+		if err != nil {
+			if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+		if found {
+			return fmt.Errorf("Lightsail Domain Entry %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSLightsailDomainEntryFound(id string) (bool, error) {
+	name, domainName, recordType, target, err := decodeLightsailDomainEntryID(id)
+	if err != nil {
+		return false, err
+	}
+
+	conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+	resp, err := conn.GetDomain(&lightsail.GetDomainInput{
+		DomainName: aws.String(domainName),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	for _, e := range resp.Domain.DomainEntries {
+		if aws.StringValue(e.Name) == name && aws.StringValue(e.Type) == recordType && aws.StringValue(e.Target) == target {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func testAccAWSLightsailDomainEntryConfig_basic(domainName string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_lightsail_domain" "test" {
+  domain_name = "%s"
+}
+
+resource "aws_lightsail_domain_entry" "test" {
+  domain_name = "${aws_lightsail_domain.test.domain_name}"
+  name        = "www.${aws_lightsail_domain.test.domain_name}"
+  type        = "A"
+  target      = "127.0.0.1"
+}
+`, domainName)
+}
@@ -11,12 +11,14 @@ import (
 	"github.com/aws/aws-sdk-go/service/lightsail"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsLightsailInstance() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsLightsailInstanceCreate,
 		Read:   resourceAwsLightsailInstanceRead,
+		Update: resourceAwsLightsailInstanceUpdate,
 		Delete: resourceAwsLightsailInstanceDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -34,9 +36,11 @@ func resourceAwsLightsailInstance() *schema.Resource {
 				ForceNew: true,
 			},
 			"blueprint_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"instance_snapshot_name"},
 			},
 			"bundle_id": {
 				Type:     schema.TypeString,
@@ -65,6 +69,40 @@ func resourceAwsLightsailInstance() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"instance_snapshot_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"port_info": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"protocol": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								lightsail.NetworkProtocolAll,
+								lightsail.NetworkProtocolTcp,
+								lightsail.NetworkProtocolUdp,
+							}, false),
+						},
+						"from_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+						"to_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+					},
+				},
+			},
 
 			// additional info returned from the API
 			"arn": {
@@ -112,30 +150,59 @@ func resourceAwsLightsailInstanceCreate(d *schema.ResourceData, meta interface{}
 
 	iName := d.Get("name").(string)
 
-	req := lightsail.CreateInstancesInput{
-		AvailabilityZone: aws.String(d.Get("availability_zone").(string)),
-		BlueprintId:      aws.String(d.Get("blueprint_id").(string)),
-		BundleId:         aws.String(d.Get("bundle_id").(string)),
-		InstanceNames:    aws.StringSlice([]string{iName}),
-	}
+	var operations []*lightsail.Operation
+	if v, ok := d.GetOk("instance_snapshot_name"); ok {
+		req := lightsail.CreateInstancesFromSnapshotInput{
+			AvailabilityZone:     aws.String(d.Get("availability_zone").(string)),
+			BundleId:             aws.String(d.Get("bundle_id").(string)),
+			InstanceNames:        aws.StringSlice([]string{iName}),
+			InstanceSnapshotName: aws.String(v.(string)),
+		}
 
-	if v, ok := d.GetOk("key_pair_name"); ok {
-		req.KeyPairName = aws.String(v.(string))
-	}
-	if v, ok := d.GetOk("user_data"); ok {
-		req.UserData = aws.String(v.(string))
-	}
+		if v, ok := d.GetOk("key_pair_name"); ok {
+			req.KeyPairName = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("user_data"); ok {
+			req.UserData = aws.String(v.(string))
+		}
 
-	resp, err := conn.CreateInstances(&req)
-	if err != nil {
-		return err
+		resp, err := conn.CreateInstancesFromSnapshot(&req)
+		if err != nil {
+			return err
+		}
+		operations = resp.Operations
+	} else {
+		blueprintId, ok := d.GetOk("blueprint_id")
+		if !ok {
+			return fmt.Errorf("One of blueprint_id or instance_snapshot_name must be specified")
+		}
+
+		req := lightsail.CreateInstancesInput{
+			AvailabilityZone: aws.String(d.Get("availability_zone").(string)),
+			BlueprintId:      aws.String(blueprintId.(string)),
+			BundleId:         aws.String(d.Get("bundle_id").(string)),
+			InstanceNames:    aws.StringSlice([]string{iName}),
+		}
+
+		if v, ok := d.GetOk("key_pair_name"); ok {
+			req.KeyPairName = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("user_data"); ok {
+			req.UserData = aws.String(v.(string))
+		}
+
+		resp, err := conn.CreateInstances(&req)
+		if err != nil {
+			return err
+		}
+		operations = resp.Operations
 	}
 
-	if len(resp.Operations) == 0 {
+	if len(operations) == 0 {
 		return fmt.Errorf("[ERR] No operations found for CreateInstance request")
 	}
 
-	op := resp.Operations[0]
+	op := operations[0]
 	d.SetId(d.Get("name").(string))
 
 	stateConf := &resource.StateChangeConf{
@@ -147,12 +214,18 @@ func resourceAwsLightsailInstanceCreate(d *schema.ResourceData, meta interface{}
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err := stateConf.WaitForState()
 	if err != nil {
 		// We don't return an error here because the Create call succeded
 		log.Printf("[ERR] Error waiting for instance (%s) to become ready: %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("port_info"); ok {
+		if err := putLightsailInstancePublicPorts(d.Id(), v.(*schema.Set).List(), meta); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsLightsailInstanceRead(d, meta)
 }
 
@@ -199,9 +272,41 @@ func resourceAwsLightsailInstanceRead(d *schema.ResourceData, meta interface{})
 	d.Set("private_ip_address", i.PrivateIpAddress)
 	d.Set("public_ip_address", i.PublicIpAddress)
 
+	portsResp, err := conn.GetInstancePortStates(&lightsail.GetInstancePortStatesInput{
+		InstanceName: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading Lightsail Instance (%s) port states: %s", d.Id(), err)
+	}
+
+	portInfos := make([]map[string]interface{}, 0, len(portsResp.PortStates))
+	for _, p := range portsResp.PortStates {
+		if aws.StringValue(p.State) != lightsail.PortStateOpen {
+			continue
+		}
+		portInfos = append(portInfos, map[string]interface{}{
+			"protocol":  aws.StringValue(p.Protocol),
+			"from_port": int(aws.Int64Value(p.FromPort)),
+			"to_port":   int(aws.Int64Value(p.ToPort)),
+		})
+	}
+	if err := d.Set("port_info", portInfos); err != nil {
+		return fmt.Errorf("Error setting port_info: %s", err)
+	}
+
 	return nil
 }
 
+func resourceAwsLightsailInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("port_info") {
+		if err := putLightsailInstancePublicPorts(d.Id(), d.Get("port_info").(*schema.Set).List(), meta); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsLightsailInstanceRead(d, meta)
+}
+
 func resourceAwsLightsailInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).lightsailconn
 	resp, err := conn.DeleteInstance(&lightsail.DeleteInstanceInput{
@@ -234,6 +339,39 @@ func resourceAwsLightsailInstanceDelete(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
+// putLightsailInstancePublicPorts replaces the public port rules of the
+// instance with the given port_info set elements.
+func putLightsailInstancePublicPorts(name string, l []interface{}, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	portInfos := make([]*lightsail.PortInfo, 0, len(l))
+	for _, v := range l {
+		m := v.(map[string]interface{})
+		portInfos = append(portInfos, &lightsail.PortInfo{
+			FromPort: aws.Int64(int64(m["from_port"].(int))),
+			Protocol: aws.String(m["protocol"].(string)),
+			ToPort:   aws.Int64(int64(m["to_port"].(int))),
+		})
+	}
+
+	log.Printf("[DEBUG] Putting Lightsail Instance (%s) public ports: %s", name, portInfos)
+	resp, err := conn.PutInstancePublicPorts(&lightsail.PutInstancePublicPortsInput{
+		InstanceName: aws.String(name),
+		PortInfos:    portInfos,
+	})
+	if err != nil {
+		return fmt.Errorf("Error putting Lightsail Instance (%s) public ports: %s", name, err)
+	}
+
+	if resp.Operation != nil {
+		if err := waitForLightsailOperation(resp.Operation.Id, meta); err != nil {
+			return fmt.Errorf("Error waiting for Lightsail Instance (%s) public ports: %s", name, err)
+		}
+	}
+
+	return nil
+}
+
 // method to check the status of an Operation, which is returned from
 // Create/Delete methods.
 // Status's are an aws.OperationStatus enum:
@@ -262,3 +400,19 @@ func resourceAwsLightsailOperationRefreshFunc(
 		return o, *o.Operation.Status, nil
 	}
 }
+
+// waitForLightsailOperation waits for the given Lightsail Operation to
+// complete.
+func waitForLightsailOperation(oid *string, meta interface{}) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"NotStarted", "Started"},
+		Target:     []string{"Completed", "Succeeded"},
+		Refresh:    resourceAwsLightsailOperationRefreshFunc(oid, meta),
+		Timeout:    10 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
@@ -0,0 +1,161 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsLightsailInstanceSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLightsailInstanceSnapshotCreate,
+		Read:   resourceAwsLightsailInstanceSnapshotRead,
+		Delete: resourceAwsLightsailInstanceSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"from_blueprint_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"from_bundle_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size_in_gb": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLightsailInstanceSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Creating Lightsail Instance Snapshot: %q", name)
+	_, err := conn.CreateInstanceSnapshot(&lightsail.CreateInstanceSnapshotInput{
+		InstanceName:         aws.String(d.Get("instance_name").(string)),
+		InstanceSnapshotName: aws.String(name),
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(name)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{lightsail.InstanceSnapshotStatePending},
+		Target:     []string{lightsail.InstanceSnapshotStateAvailable},
+		Refresh:    resourceAwsLightsailInstanceSnapshotStateRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Lightsail Instance Snapshot (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceAwsLightsailInstanceSnapshotRead(d, meta)
+}
+
+func resourceAwsLightsailInstanceSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+	resp, err := conn.GetInstanceSnapshot(&lightsail.GetInstanceSnapshotInput{
+		InstanceSnapshotName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Lightsail Instance Snapshot (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	snapshot := resp.InstanceSnapshot
+
+	d.Set("name", snapshot.Name)
+	d.Set("instance_name", snapshot.FromInstanceName)
+	d.Set("arn", snapshot.Arn)
+	d.Set("created_at", snapshot.CreatedAt.Format(time.RFC3339))
+	d.Set("from_blueprint_id", snapshot.FromBlueprintId)
+	d.Set("from_bundle_id", snapshot.FromBundleId)
+	d.Set("size_in_gb", snapshot.SizeInGb)
+	d.Set("state", snapshot.State)
+
+	return nil
+}
+
+func resourceAwsLightsailInstanceSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+	resp, err := conn.DeleteInstanceSnapshot(&lightsail.DeleteInstanceSnapshotInput{
+		InstanceSnapshotName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return err
+	}
+
+	if len(resp.Operations) > 0 {
+		if err := waitForLightsailOperation(resp.Operations[0].Id, meta); err != nil {
+			return fmt.Errorf("Error waiting for Lightsail Instance Snapshot (%s) to become destroyed: %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsLightsailInstanceSnapshotStateRefreshFunc(conn *lightsail.Lightsail, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.GetInstanceSnapshot(&lightsail.GetInstanceSnapshotInput{
+			InstanceSnapshotName: aws.String(name),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		state := aws.StringValue(resp.InstanceSnapshot.State)
+		if state == lightsail.InstanceSnapshotStateError {
+			return resp, state, fmt.Errorf("Lightsail Instance Snapshot (%s) is in an error state", name)
+		}
+		return resp, state, nil
+	}
+}
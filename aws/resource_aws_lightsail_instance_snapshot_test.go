@@ -0,0 +1,117 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLightsailInstanceSnapshot_basic(t *testing.T) {
+	var snapshot lightsail.InstanceSnapshot
+	var instance lightsail.Instance
+	instanceName := fmt.Sprintf("tf-test-lightsail-%s", acctest.RandString(5))
+	snapshotName := fmt.Sprintf("tf-test-lightsail-%s", acctest.RandString(5))
+	restoredName := fmt.Sprintf("tf-test-lightsail-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailInstanceSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailInstanceSnapshotConfig_basic(instanceName, snapshotName, restoredName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailInstanceSnapshotExists("aws_lightsail_instance_snapshot.test", &snapshot),
+					resource.TestCheckResourceAttr("aws_lightsail_instance_snapshot.test", "instance_name", instanceName),
+					resource.TestCheckResourceAttr("aws_lightsail_instance_snapshot.test", "state", lightsail.InstanceSnapshotStateAvailable),
+					testAccCheckAWSLightsailInstanceExists("aws_lightsail_instance.restored", &instance),
+					resource.TestCheckResourceAttrSet("aws_lightsail_instance.restored", "blueprint_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLightsailInstanceSnapshotExists(n string, snapshot *lightsail.InstanceSnapshot) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Lightsail Instance Snapshot ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+		resp, err := conn.GetInstanceSnapshot(&lightsail.GetInstanceSnapshotInput{
+			InstanceSnapshotName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if resp == nil || resp.InstanceSnapshot == nil {
+			return fmt.Errorf("Instance Snapshot (%s) not found", rs.Primary.ID)
+		}
+
+		*snapshot = *resp.InstanceSnapshot
+		return nil
+	}
+}
+
+func testAccCheckAWSLightsailInstanceSnapshotDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_instance_snapshot" {
+			continue
+		}
+
+		_, err := conn.GetInstanceSnapshot(&lightsail.GetInstanceSnapshotInput{
+			InstanceSnapshotName: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("Lightsail Instance Snapshot %q still exists", rs.Primary.ID)
+		}
+		if !isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSLightsailInstanceSnapshotConfig_basic(instanceName, snapshotName, restoredName string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_lightsail_instance" "test" {
+  name              = "%s"
+  availability_zone = "us-east-1b"
+  blueprint_id      = "gitlab_8_12_6"
+  bundle_id         = "nano_1_0"
+}
+
+resource "aws_lightsail_instance_snapshot" "test" {
+  name          = "%s"
+  instance_name = "${aws_lightsail_instance.test.name}"
+}
+
+resource "aws_lightsail_instance" "restored" {
+  name                   = "%s"
+  availability_zone      = "us-east-1b"
+  bundle_id              = "nano_1_0"
+  instance_snapshot_name = "${aws_lightsail_instance_snapshot.test.name}"
+}
+`, instanceName, snapshotName, restoredName)
+}
@@ -62,6 +62,33 @@ func TestAccAWSLightsailInstance_euRegion(t *testing.T) {
 	})
 }
 
+func TestAccAWSLightsailInstance_portInfo(t *testing.T) {
+	var conf lightsail.Instance
+	lightsailName := fmt.Sprintf("tf-test-lightsail-%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailInstanceConfig_portInfo(lightsailName, 80),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailInstanceExists("aws_lightsail_instance.lightsail_instance_test", &conf),
+					resource.TestCheckResourceAttr("aws_lightsail_instance.lightsail_instance_test", "port_info.#", "2"),
+				),
+			},
+			{
+				Config: testAccAWSLightsailInstanceConfig_portInfo(lightsailName, 8080),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailInstanceExists("aws_lightsail_instance.lightsail_instance_test", &conf),
+					resource.TestCheckResourceAttr("aws_lightsail_instance.lightsail_instance_test", "port_info.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSLightsailInstance_disapear(t *testing.T) {
 	var conf lightsail.Instance
 	lightsailName := fmt.Sprintf("tf-test-lightsail-%d", acctest.RandInt())
@@ -187,3 +214,29 @@ resource "aws_lightsail_instance" "lightsail_instance_test" {
 }
 `, lightsailName)
 }
+
+func testAccAWSLightsailInstanceConfig_portInfo(lightsailName string, port int) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  region = "us-east-1"
+}
+resource "aws_lightsail_instance" "lightsail_instance_test" {
+  name              = "%s"
+  availability_zone = "us-east-1b"
+  blueprint_id      = "gitlab_8_12_6"
+  bundle_id         = "nano_1_0"
+
+  port_info {
+    protocol  = "tcp"
+    from_port = 22
+    to_port   = 22
+  }
+
+  port_info {
+    protocol  = "tcp"
+    from_port = %d
+    to_port   = %d
+  }
+}
+`, lightsailName, port, port)
+}
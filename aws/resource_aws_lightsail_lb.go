@@ -0,0 +1,173 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLightsailLoadBalancer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLightsailLoadBalancerCreate,
+		Read:   resourceAwsLightsailLoadBalancerRead,
+		Update: resourceAwsLightsailLoadBalancerUpdate,
+		Delete: resourceAwsLightsailLoadBalancerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+			},
+			"health_check_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "/",
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dns_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_ports": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	}
+}
+
+func resourceAwsLightsailLoadBalancerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	name := d.Get("name").(string)
+	req := lightsail.CreateLoadBalancerInput{
+		InstancePort:     aws.Int64(int64(d.Get("instance_port").(int))),
+		LoadBalancerName: aws.String(name),
+	}
+	if v, ok := d.GetOk("health_check_path"); ok {
+		req.HealthCheckPath = aws.String(v.(string))
+	}
+
+	log.Printf("[INFO] Creating Lightsail Load Balancer: %q", name)
+	resp, err := conn.CreateLoadBalancer(&req)
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Operations) == 0 {
+		return fmt.Errorf("[ERR] No operations found for CreateLoadBalancer request")
+	}
+
+	d.SetId(name)
+
+	if err := waitForLightsailOperation(resp.Operations[0].Id, meta); err != nil {
+		return fmt.Errorf("Error waiting for Lightsail Load Balancer (%s) to become ready: %s", d.Id(), err)
+	}
+
+	return resourceAwsLightsailLoadBalancerRead(d, meta)
+}
+
+func resourceAwsLightsailLoadBalancerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+	resp, err := conn.GetLoadBalancer(&lightsail.GetLoadBalancerInput{
+		LoadBalancerName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Lightsail Load Balancer (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	lb := resp.LoadBalancer
+
+	d.Set("name", lb.Name)
+	d.Set("instance_port", lb.InstancePort)
+	d.Set("health_check_path", lb.HealthCheckPath)
+	d.Set("arn", lb.Arn)
+	d.Set("created_at", lb.CreatedAt.Format(time.RFC3339))
+	d.Set("dns_name", lb.DnsName)
+	d.Set("protocol", lb.Protocol)
+	ports := make([]int, 0, len(lb.PublicPorts))
+	for _, p := range lb.PublicPorts {
+		ports = append(ports, int(aws.Int64Value(p)))
+	}
+	if err := d.Set("public_ports", ports); err != nil {
+		return fmt.Errorf("Error setting public_ports: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLightsailLoadBalancerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	if d.HasChange("health_check_path") {
+		resp, err := conn.UpdateLoadBalancerAttribute(&lightsail.UpdateLoadBalancerAttributeInput{
+			AttributeName:    aws.String(lightsail.LoadBalancerAttributeNameHealthCheckPath),
+			AttributeValue:   aws.String(d.Get("health_check_path").(string)),
+			LoadBalancerName: aws.String(d.Id()),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.Operations) > 0 {
+			if err := waitForLightsailOperation(resp.Operations[0].Id, meta); err != nil {
+				return fmt.Errorf("Error waiting for Lightsail Load Balancer (%s) to be updated: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceAwsLightsailLoadBalancerRead(d, meta)
+}
+
+func resourceAwsLightsailLoadBalancerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+	resp, err := conn.DeleteLoadBalancer(&lightsail.DeleteLoadBalancerInput{
+		LoadBalancerName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return err
+	}
+
+	if len(resp.Operations) > 0 {
+		if err := waitForLightsailOperation(resp.Operations[0].Id, meta); err != nil {
+			return fmt.Errorf("Error waiting for Lightsail Load Balancer (%s) to become destroyed: %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsLightsailLoadBalancerAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLightsailLoadBalancerAttachmentCreate,
+		Read:   resourceAwsLightsailLoadBalancerAttachmentRead,
+		Delete: resourceAwsLightsailLoadBalancerAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"lb_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLightsailLoadBalancerAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	lbName := d.Get("lb_name").(string)
+	instanceName := d.Get("instance_name").(string)
+
+	log.Printf("[INFO] Attaching Lightsail Instance %q to Load Balancer %q", instanceName, lbName)
+	resp, err := conn.AttachInstancesToLoadBalancer(&lightsail.AttachInstancesToLoadBalancerInput{
+		InstanceNames:    aws.StringSlice([]string{instanceName}),
+		LoadBalancerName: aws.String(lbName),
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", lbName, instanceName))
+
+	if len(resp.Operations) > 0 {
+		if err := waitForLightsailOperation(resp.Operations[0].Id, meta); err != nil {
+			return fmt.Errorf("Error waiting for Lightsail Load Balancer Attachment (%s) to become ready: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsLightsailLoadBalancerAttachmentRead(d, meta)
+}
+
+func resourceAwsLightsailLoadBalancerAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	lbName, instanceName, err := decodeLightsailLoadBalancerAttachmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.GetLoadBalancer(&lightsail.GetLoadBalancerInput{
+		LoadBalancerName: aws.String(lbName),
+	})
+	if err != nil {
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Lightsail Load Balancer (%s) not found, removing attachment from state", lbName)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	var found bool
+	for _, s := range resp.LoadBalancer.InstanceHealthSummary {
+		if aws.StringValue(s.InstanceName) == instanceName {
+			found = true
+			break
+		}
+	}
+	if !found {
+		log.Printf("[WARN] Lightsail Load Balancer Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("lb_name", lbName)
+	d.Set("instance_name", instanceName)
+
+	return nil
+}
+
+func resourceAwsLightsailLoadBalancerAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	lbName, instanceName, err := decodeLightsailLoadBalancerAttachmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Detaching Lightsail Instance %q from Load Balancer %q", instanceName, lbName)
+	resp, err := conn.DetachInstancesFromLoadBalancer(&lightsail.DetachInstancesFromLoadBalancerInput{
+		InstanceNames:    aws.StringSlice([]string{instanceName}),
+		LoadBalancerName: aws.String(lbName),
+	})
+	if err != nil {
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return err
+	}
+
+	if len(resp.Operations) > 0 {
+		if err := waitForLightsailOperation(resp.Operations[0].Id, meta); err != nil {
+			return fmt.Errorf("Error waiting for Lightsail Load Balancer Attachment (%s) to become destroyed: %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func decodeLightsailLoadBalancerAttachmentID(id string) (string, string, error) {
+	parts := strings.Split(id, ",")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Lightsail Load Balancer Attachment ID must be of the form <lb_name>,<instance_name>, was provided: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,174 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDecodeLightsailLoadBalancerAttachmentID(t *testing.T) {
+	var testCases = []struct {
+		Input        string
+		LbName       string
+		InstanceName string
+		ErrCount     int
+	}{
+		{
+			Input:    "",
+			ErrCount: 1,
+		},
+		{
+			Input:    "test-lb",
+			ErrCount: 1,
+		},
+		{
+			Input:    "test-lb,",
+			ErrCount: 1,
+		},
+		{
+			Input:        "test-lb,test-instance",
+			LbName:       "test-lb",
+			InstanceName: "test-instance",
+			ErrCount:     0,
+		},
+	}
+
+	for _, tc := range testCases {
+		lbName, instanceName, err := decodeLightsailLoadBalancerAttachmentID(tc.Input)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.Input, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.Input)
+		}
+		if lbName != tc.LbName {
+			t.Fatalf("expected %q to return load balancer name %q, received: %q", tc.Input, tc.LbName, lbName)
+		}
+		if instanceName != tc.InstanceName {
+			t.Fatalf("expected %q to return instance name %q, received: %q", tc.Input, tc.InstanceName, instanceName)
+		}
+	}
+}
+
+func TestAccAWSLightsailLoadBalancerAttachment_basic(t *testing.T) {
+	lbName := fmt.Sprintf("tf-test-lightsail-%s", acctest.RandString(5))
+	instanceName := fmt.Sprintf("tf-test-lightsail-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailLoadBalancerAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailLoadBalancerAttachmentConfig_basic(lbName, instanceName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailLoadBalancerAttachmentExists("aws_lightsail_lb_attachment.test"),
+				),
+			},
+			{
+				ResourceName:      "aws_lightsail_lb_attachment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLightsailLoadBalancerAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Lightsail Load Balancer Attachment ID is set")
+		}
+
+		attached, err := testAccAWSLightsailLoadBalancerAttachmentAttached(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if !attached {
+			return fmt.Errorf("Load Balancer Attachment (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSLightsailLoadBalancerAttachmentDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_lb_attachment" {
+			continue
+		}
+
+		attached, err := testAccAWSLightsailLoadBalancerAttachmentAttached(rs.Primary.ID)
+		if err != nil {
+			if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+		if attached {
+			return fmt.Errorf("Lightsail Load Balancer Attachment %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSLightsailLoadBalancerAttachmentAttached(id string) (bool, error) {
+	lbName, instanceName, err := decodeLightsailLoadBalancerAttachmentID(id)
+	if err != nil {
+		return false, err
+	}
+
+	conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+	resp, err := conn.GetLoadBalancer(&lightsail.GetLoadBalancerInput{
+		LoadBalancerName: aws.String(lbName),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	for _, s := range resp.LoadBalancer.InstanceHealthSummary {
+		if aws.StringValue(s.InstanceName) == instanceName {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func testAccAWSLightsailLoadBalancerAttachmentConfig_basic(lbName, instanceName string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_lightsail_lb" "test" {
+  name          = "%s"
+  instance_port = 80
+}
+
+resource "aws_lightsail_instance" "test" {
+  name              = "%s"
+  availability_zone = "us-east-1b"
+  blueprint_id      = "gitlab_8_12_6"
+  bundle_id         = "nano_1_0"
+}
+
+resource "aws_lightsail_lb_attachment" "test" {
+  lb_name       = "${aws_lightsail_lb.test.name}"
+  instance_name = "${aws_lightsail_instance.test.name}"
+}
+`, lbName, instanceName)
+}
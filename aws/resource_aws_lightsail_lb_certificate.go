@@ -0,0 +1,224 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsLightsailLoadBalancerCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLightsailLoadBalancerCertificateCreate,
+		Read:   resourceAwsLightsailLoadBalancerCertificateRead,
+		Delete: resourceAwsLightsailLoadBalancerCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"lb_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subject_alternative_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_validation_records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsLightsailLoadBalancerCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	lbName := d.Get("lb_name").(string)
+	name := d.Get("name").(string)
+
+	req := lightsail.CreateLoadBalancerTlsCertificateInput{
+		CertificateDomainName: aws.String(d.Get("domain_name").(string)),
+		CertificateName:       aws.String(name),
+		LoadBalancerName:      aws.String(lbName),
+	}
+	if v, ok := d.GetOk("subject_alternative_names"); ok {
+		req.CertificateAlternativeNames = expandStringList(v.(*schema.Set).List())
+	}
+
+	log.Printf("[INFO] Creating Lightsail Load Balancer Certificate: %q", name)
+	resp, err := conn.CreateLoadBalancerTlsCertificate(&req)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", lbName, name))
+
+	if len(resp.Operations) > 0 {
+		if err := waitForLightsailOperation(resp.Operations[0].Id, meta); err != nil {
+			return fmt.Errorf("Error waiting for Lightsail Load Balancer Certificate (%s) to become ready: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsLightsailLoadBalancerCertificateRead(d, meta)
+}
+
+func resourceAwsLightsailLoadBalancerCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	lbName, name, err := decodeLightsailLoadBalancerCertificateID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	cert, err := findLightsailLoadBalancerCertificate(conn, lbName, name)
+	if err != nil {
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Lightsail Load Balancer (%s) not found, removing certificate from state", lbName)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	if cert == nil {
+		log.Printf("[WARN] Lightsail Load Balancer Certificate (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("lb_name", lbName)
+	d.Set("name", cert.Name)
+	d.Set("domain_name", cert.DomainName)
+	d.Set("arn", cert.Arn)
+	d.Set("created_at", cert.CreatedAt.Format(time.RFC3339))
+	d.Set("status", cert.Status)
+	if err := d.Set("subject_alternative_names", flattenStringList(cert.SubjectAlternativeNames)); err != nil {
+		return fmt.Errorf("Error setting subject_alternative_names: %s", err)
+	}
+
+	records := make([]map[string]interface{}, 0, len(cert.DomainValidationRecords))
+	for _, r := range cert.DomainValidationRecords {
+		records = append(records, map[string]interface{}{
+			"domain_name": aws.StringValue(r.DomainName),
+			"name":        aws.StringValue(r.Name),
+			"type":        aws.StringValue(r.Type),
+			"value":       aws.StringValue(r.Value),
+		})
+	}
+	if err := d.Set("domain_validation_records", records); err != nil {
+		return fmt.Errorf("Error setting domain_validation_records: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLightsailLoadBalancerCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	lbName, name, err := decodeLightsailLoadBalancerCertificateID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Force is required to delete a certificate that is attached to the
+	// load balancer, as there is no API to detach it.
+	resp, err := conn.DeleteLoadBalancerTlsCertificate(&lightsail.DeleteLoadBalancerTlsCertificateInput{
+		CertificateName:  aws.String(name),
+		Force:            aws.Bool(true),
+		LoadBalancerName: aws.String(lbName),
+	})
+	if err != nil {
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return err
+	}
+
+	if len(resp.Operations) > 0 {
+		if err := waitForLightsailOperation(resp.Operations[0].Id, meta); err != nil {
+			return fmt.Errorf("Error waiting for Lightsail Load Balancer Certificate (%s) to become destroyed: %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+// findLightsailLoadBalancerCertificate returns the named certificate of the
+// given load balancer, or nil if there is none.
+func findLightsailLoadBalancerCertificate(conn *lightsail.Lightsail, lbName, name string) (*lightsail.LoadBalancerTlsCertificate, error) {
+	resp, err := conn.GetLoadBalancerTlsCertificates(&lightsail.GetLoadBalancerTlsCertificatesInput{
+		LoadBalancerName: aws.String(lbName),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cert := range resp.TlsCertificates {
+		if aws.StringValue(cert.Name) == name {
+			return cert, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func decodeLightsailLoadBalancerCertificateID(id string) (string, string, error) {
+	parts := strings.Split(id, ",")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Lightsail Load Balancer Certificate ID must be of the form <lb_name>,<certificate_name>, was provided: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,125 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsLightsailLoadBalancerCertificateAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLightsailLoadBalancerCertificateAttachmentCreate,
+		Read:   resourceAwsLightsailLoadBalancerCertificateAttachmentRead,
+		Delete: resourceAwsLightsailLoadBalancerCertificateAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(45 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"lb_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"certificate_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLightsailLoadBalancerCertificateAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	lbName := d.Get("lb_name").(string)
+	certName := d.Get("certificate_name").(string)
+
+	// Only issued certificates can be attached, so wait for domain
+	// validation to complete first.
+	log.Printf("[DEBUG] Waiting for Lightsail Certificate %q to be issued", certName)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lightsail.LoadBalancerTlsCertificateStatusPendingValidation},
+		Target:  []string{lightsail.LoadBalancerTlsCertificateStatusIssued},
+		Refresh: func() (interface{}, string, error) {
+			cert, err := findLightsailLoadBalancerCertificate(conn, lbName, certName)
+			if err != nil {
+				return nil, "", err
+			}
+			if cert == nil {
+				return nil, "", fmt.Errorf("Lightsail Certificate %q not found on Load Balancer %q", certName, lbName)
+			}
+			return cert, aws.StringValue(cert.Status), nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Lightsail Certificate %q to be issued: %s", certName, err)
+	}
+
+	log.Printf("[INFO] Attaching Lightsail Certificate %q to Load Balancer %q", certName, lbName)
+	resp, err := conn.AttachLoadBalancerTlsCertificate(&lightsail.AttachLoadBalancerTlsCertificateInput{
+		CertificateName:  aws.String(certName),
+		LoadBalancerName: aws.String(lbName),
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", lbName, certName))
+
+	if len(resp.Operations) > 0 {
+		if err := waitForLightsailOperation(resp.Operations[0].Id, meta); err != nil {
+			return fmt.Errorf("Error waiting for Lightsail Load Balancer Certificate Attachment (%s) to become ready: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsLightsailLoadBalancerCertificateAttachmentRead(d, meta)
+}
+
+func resourceAwsLightsailLoadBalancerCertificateAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	lbName, certName, err := decodeLightsailLoadBalancerCertificateID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	cert, err := findLightsailLoadBalancerCertificate(conn, lbName, certName)
+	if err != nil {
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Lightsail Load Balancer (%s) not found, removing certificate attachment from state", lbName)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	if cert == nil || !aws.BoolValue(cert.IsAttached) {
+		log.Printf("[WARN] Lightsail Load Balancer Certificate Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("lb_name", lbName)
+	d.Set("certificate_name", certName)
+
+	return nil
+}
+
+func resourceAwsLightsailLoadBalancerCertificateAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	// There is no API to detach a certificate from a load balancer, the
+	// certificate stays attached until it is deleted.
+	log.Printf("[WARN] Lightsail Load Balancer Certificate Attachment (%s) cannot be detached, removing from state only", d.Id())
+	return nil
+}
@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLightsailLoadBalancerCertificateAttachment_basic(t *testing.T) {
+	// Attaching requires an issued certificate, which is validated through
+	// DNS records created in this Route 53 hosted zone.
+	zoneName := os.Getenv("LIGHTSAIL_LB_CERTIFICATE_ZONE_NAME")
+	if zoneName == "" {
+		t.Skip("Environment variable LIGHTSAIL_LB_CERTIFICATE_ZONE_NAME is not set")
+	}
+
+	lbName := fmt.Sprintf("tf-test-lightsail-%s", acctest.RandString(5))
+	certName := fmt.Sprintf("tf-test-lightsail-%s", acctest.RandString(5))
+	domainName := fmt.Sprintf("tf-test-%s.%s", acctest.RandString(8), zoneName)
+	resourceName := "aws_lightsail_lb_certificate_attachment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		// Destroying the attachment only removes it from state, the
+		// certificate is detached when it is deleted.
+		CheckDestroy: testAccCheckAWSLightsailLoadBalancerCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailLoadBalancerCertificateAttachmentConfig_basic(lbName, certName, domainName, zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLightsailLoadBalancerCertificateAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "lb_name", lbName),
+					resource.TestCheckResourceAttr(resourceName, "certificate_name", certName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLightsailLoadBalancerCertificateAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Lightsail Load Balancer Certificate Attachment ID is set")
+		}
+
+		lbName, certName, err := decodeLightsailLoadBalancerCertificateID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+		cert, err := findLightsailLoadBalancerCertificate(conn, lbName, certName)
+		if err != nil {
+			return err
+		}
+		if cert == nil || !aws.BoolValue(cert.IsAttached) {
+			return fmt.Errorf("Load Balancer Certificate (%s) is not attached", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSLightsailLoadBalancerCertificateAttachmentConfig_basic(lbName, certName, domainName, zoneName string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  region = "us-east-1"
+}
+
+data "aws_route53_zone" "test" {
+  name = "%s."
+}
+
+resource "aws_lightsail_lb" "test" {
+  name          = "%s"
+  instance_port = 80
+}
+
+resource "aws_lightsail_lb_certificate" "test" {
+  lb_name     = "${aws_lightsail_lb.test.name}"
+  name        = "%s"
+  domain_name = "%s"
+}
+
+resource "aws_route53_record" "validation" {
+  zone_id = "${data.aws_route53_zone.test.zone_id}"
+  name    = "${lookup(aws_lightsail_lb_certificate.test.domain_validation_records[0], "name")}"
+  type    = "${lookup(aws_lightsail_lb_certificate.test.domain_validation_records[0], "type")}"
+  records = ["${lookup(aws_lightsail_lb_certificate.test.domain_validation_records[0], "value")}"]
+  ttl     = 60
+}
+
+resource "aws_lightsail_lb_certificate_attachment" "test" {
+  depends_on = ["aws_route53_record.validation"]
+
+  lb_name          = "${aws_lightsail_lb.test.name}"
+  certificate_name = "${aws_lightsail_lb_certificate.test.name}"
+}
+`, zoneName, lbName, certName, domainName)
+}
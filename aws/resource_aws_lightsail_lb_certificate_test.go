@@ -0,0 +1,161 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDecodeLightsailLoadBalancerCertificateID(t *testing.T) {
+	var testCases = []struct {
+		Input    string
+		LbName   string
+		CertName string
+		ErrCount int
+	}{
+		{
+			Input:    "",
+			ErrCount: 1,
+		},
+		{
+			Input:    "test-lb",
+			ErrCount: 1,
+		},
+		{
+			Input:    ",test-cert",
+			ErrCount: 1,
+		},
+		{
+			Input:    "test-lb,test-cert",
+			LbName:   "test-lb",
+			CertName: "test-cert",
+			ErrCount: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		lbName, certName, err := decodeLightsailLoadBalancerCertificateID(tc.Input)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.Input, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.Input)
+		}
+		if lbName != tc.LbName {
+			t.Fatalf("expected %q to return load balancer name %q, received: %q", tc.Input, tc.LbName, lbName)
+		}
+		if certName != tc.CertName {
+			t.Fatalf("expected %q to return certificate name %q, received: %q", tc.Input, tc.CertName, certName)
+		}
+	}
+}
+
+func TestAccAWSLightsailLoadBalancerCertificate_basic(t *testing.T) {
+	lbName := fmt.Sprintf("tf-test-lightsail-%s", acctest.RandString(5))
+	certName := fmt.Sprintf("tf-test-lightsail-%s", acctest.RandString(5))
+	domainName := fmt.Sprintf("%s.example.com", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailLoadBalancerCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailLoadBalancerCertificateConfig_basic(lbName, certName, domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailLoadBalancerCertificateExists("aws_lightsail_lb_certificate.test"),
+					resource.TestCheckResourceAttr("aws_lightsail_lb_certificate.test", "domain_name", domainName),
+					resource.TestCheckResourceAttrSet("aws_lightsail_lb_certificate.test", "arn"),
+					resource.TestCheckResourceAttrSet("aws_lightsail_lb_certificate.test", "domain_validation_records.#"),
+				),
+			},
+			{
+				ResourceName:      "aws_lightsail_lb_certificate.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLightsailLoadBalancerCertificateExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Lightsail Load Balancer Certificate ID is set")
+		}
+
+		lbName, certName, err := decodeLightsailLoadBalancerCertificateID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+		cert, err := findLightsailLoadBalancerCertificate(conn, lbName, certName)
+		if err != nil {
+			return err
+		}
+		if cert == nil {
+			return fmt.Errorf("Load Balancer Certificate (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSLightsailLoadBalancerCertificateDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_lb_certificate" {
+			continue
+		}
+
+		lbName, certName, err := decodeLightsailLoadBalancerCertificateID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		cert, err := findLightsailLoadBalancerCertificate(conn, lbName, certName)
+		if err != nil {
+			if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+		if cert != nil {
+			return fmt.Errorf("Lightsail Load Balancer Certificate %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSLightsailLoadBalancerCertificateConfig_basic(lbName, certName, domainName string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_lightsail_lb" "test" {
+  name          = "%s"
+  instance_port = 80
+}
+
+resource "aws_lightsail_lb_certificate" "test" {
+  lb_name     = "${aws_lightsail_lb.test.name}"
+  name        = "%s"
+  domain_name = "%s"
+}
+`, lbName, certName, domainName)
+}
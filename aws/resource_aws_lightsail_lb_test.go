@@ -0,0 +1,112 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLightsailLoadBalancer_basic(t *testing.T) {
+	var lb lightsail.LoadBalancer
+	lbName := fmt.Sprintf("tf-test-lightsail-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailLoadBalancerConfig_basic(lbName, "/"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailLoadBalancerExists("aws_lightsail_lb.test", &lb),
+					resource.TestCheckResourceAttr("aws_lightsail_lb.test", "instance_port", "80"),
+					resource.TestCheckResourceAttr("aws_lightsail_lb.test", "health_check_path", "/"),
+					resource.TestCheckResourceAttrSet("aws_lightsail_lb.test", "dns_name"),
+				),
+			},
+			{
+				Config: testAccAWSLightsailLoadBalancerConfig_basic(lbName, "/healthcheck"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailLoadBalancerExists("aws_lightsail_lb.test", &lb),
+					resource.TestCheckResourceAttr("aws_lightsail_lb.test", "health_check_path", "/healthcheck"),
+				),
+			},
+			{
+				ResourceName:      "aws_lightsail_lb.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLightsailLoadBalancerExists(n string, lb *lightsail.LoadBalancer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Lightsail Load Balancer ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+		resp, err := conn.GetLoadBalancer(&lightsail.GetLoadBalancerInput{
+			LoadBalancerName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if resp == nil || resp.LoadBalancer == nil {
+			return fmt.Errorf("Load Balancer (%s) not found", rs.Primary.ID)
+		}
+
+		*lb = *resp.LoadBalancer
+		return nil
+	}
+}
+
+func testAccCheckAWSLightsailLoadBalancerDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_lb" {
+			continue
+		}
+
+		_, err := conn.GetLoadBalancer(&lightsail.GetLoadBalancerInput{
+			LoadBalancerName: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("Lightsail Load Balancer %q still exists", rs.Primary.ID)
+		}
+		if !isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSLightsailLoadBalancerConfig_basic(lbName, healthCheckPath string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_lightsail_lb" "test" {
+  name              = "%s"
+  instance_port     = 80
+  health_check_path = "%s"
+}
+`, lbName, healthCheckPath)
+}
@@ -0,0 +1,145 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsNetworkInterfacePermission() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsNetworkInterfacePermissionCreate,
+		Read:   resourceAwsNetworkInterfacePermissionRead,
+		Delete: resourceAwsNetworkInterfacePermissionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_interface_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"permission": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.InterfacePermissionTypeInstanceAttach,
+					ec2.InterfacePermissionTypeEipAssociate,
+				}, false),
+			},
+			"aws_account_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"aws_service"},
+			},
+			"aws_service": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"aws_account_id"},
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsNetworkInterfacePermissionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.CreateNetworkInterfacePermissionInput{
+		NetworkInterfaceId: aws.String(d.Get("network_interface_id").(string)),
+		Permission:         aws.String(d.Get("permission").(string)),
+	}
+
+	accountId, accountOk := d.GetOk("aws_account_id")
+	service, serviceOk := d.GetOk("aws_service")
+	if !accountOk && !serviceOk {
+		return fmt.Errorf("One of aws_account_id or aws_service must be set")
+	}
+	if accountOk {
+		input.AwsAccountId = aws.String(accountId.(string))
+	}
+	if serviceOk {
+		input.AwsService = aws.String(service.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Network Interface Permission: %s", input)
+	resp, err := conn.CreateNetworkInterfacePermission(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Network Interface Permission: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.InterfacePermission.NetworkInterfacePermissionId))
+
+	return resourceAwsNetworkInterfacePermissionRead(d, meta)
+}
+
+func resourceAwsNetworkInterfacePermissionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	resp, err := conn.DescribeNetworkInterfacePermissions(&ec2.DescribeNetworkInterfacePermissionsInput{
+		NetworkInterfacePermissionIds: []*string{aws.String(d.Id())},
+	})
+	if err != nil {
+		if isAWSErr(err, "InvalidPermissionId.NotFound", "") {
+			log.Printf("[WARN] Network Interface Permission (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Network Interface Permission (%s): %s", d.Id(), err)
+	}
+
+	if len(resp.NetworkInterfacePermissions) == 0 {
+		log.Printf("[WARN] Network Interface Permission (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	permission := resp.NetworkInterfacePermissions[0]
+
+	state := ""
+	if permission.PermissionState != nil {
+		state = aws.StringValue(permission.PermissionState.State)
+	}
+	if state == ec2.NetworkInterfacePermissionStateCodeRevoking || state == ec2.NetworkInterfacePermissionStateCodeRevoked {
+		log.Printf("[WARN] Network Interface Permission (%s) is %s, removing from state", d.Id(), state)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("network_interface_id", permission.NetworkInterfaceId)
+	d.Set("permission", permission.Permission)
+	d.Set("aws_account_id", permission.AwsAccountId)
+	d.Set("aws_service", permission.AwsService)
+	d.Set("state", state)
+
+	return nil
+}
+
+func resourceAwsNetworkInterfacePermissionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[DEBUG] Deleting Network Interface Permission: %s", d.Id())
+	_, err := conn.DeleteNetworkInterfacePermission(&ec2.DeleteNetworkInterfacePermissionInput{
+		NetworkInterfacePermissionId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "InvalidPermissionId.NotFound", "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Network Interface Permission (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
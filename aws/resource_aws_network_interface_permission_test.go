@@ -0,0 +1,129 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSNetworkInterfacePermission_basic(t *testing.T) {
+	accountID := os.Getenv("NETWORK_INTERFACE_PERMISSION_ACCOUNT_ID")
+	if accountID == "" {
+		t.Skip("Environment variable NETWORK_INTERFACE_PERMISSION_ACCOUNT_ID is not set")
+	}
+
+	var permission ec2.NetworkInterfacePermission
+	resourceName := "aws_network_interface_permission.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkInterfacePermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkInterfacePermissionConfig(accountID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkInterfacePermissionExists(resourceName, &permission),
+					resource.TestCheckResourceAttr(resourceName, "permission", "INSTANCE-ATTACH"),
+					resource.TestCheckResourceAttr(resourceName, "aws_account_id", accountID),
+					resource.TestCheckResourceAttr(resourceName, "state", "granted"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSNetworkInterfacePermissionExists(n string, res *ec2.NetworkInterfacePermission) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Network Interface Permission ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		resp, err := conn.DescribeNetworkInterfacePermissions(&ec2.DescribeNetworkInterfacePermissionsInput{
+			NetworkInterfacePermissionIds: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.NetworkInterfacePermissions) != 1 {
+			return fmt.Errorf("Network Interface Permission %q not found", rs.Primary.ID)
+		}
+
+		*res = *resp.NetworkInterfacePermissions[0]
+		return nil
+	}
+}
+
+func testAccCheckAWSNetworkInterfacePermissionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_network_interface_permission" {
+			continue
+		}
+
+		resp, err := conn.DescribeNetworkInterfacePermissions(&ec2.DescribeNetworkInterfacePermissionsInput{
+			NetworkInterfacePermissionIds: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			if isAWSErr(err, "InvalidPermissionId.NotFound", "") {
+				continue
+			}
+			return err
+		}
+
+		for _, p := range resp.NetworkInterfacePermissions {
+			if p.PermissionState != nil && aws.StringValue(p.PermissionState.State) == ec2.NetworkInterfacePermissionStateCodeGranted {
+				return fmt.Errorf("Network Interface Permission %q still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSNetworkInterfacePermissionConfig(accountID string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+  tags {
+    Name = "terraform-testacc-network-interface-permission"
+  }
+}
+
+resource "aws_subnet" "test" {
+  cidr_block = "10.0.0.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  vpc_id = "${aws_vpc.test.id}"
+}
+
+resource "aws_network_interface" "test" {
+  subnet_id = "${aws_subnet.test.id}"
+}
+
+resource "aws_network_interface_permission" "test" {
+  network_interface_id = "${aws_network_interface.test.id}"
+  permission = "INSTANCE-ATTACH"
+  aws_account_id = "%s"
+}
+`, accountID)
+}
@@ -0,0 +1,24 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/service/opsworks"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsOpsworksEcsClusterLayer() *schema.Resource {
+	layerType := &opsworksLayerType{
+		TypeName:         opsworks.LayerTypeEcsCluster,
+		DefaultLayerName: "Ecs Cluster",
+
+		Attributes: map[string]*opsworksLayerTypeAttribute{
+			"ecs_cluster_arn": {
+				AttrName: opsworks.LayerAttributesKeysEcsClusterArn,
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+
+	return layerType.SchemaResource()
+}
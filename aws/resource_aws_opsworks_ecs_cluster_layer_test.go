@@ -0,0 +1,86 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opsworks"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// These tests assume the existence of predefined Opsworks IAM roles named `aws-opsworks-ec2-role`
+// and `aws-opsworks-service-role`.
+
+func TestAccAWSOpsworksEcsClusterLayer(t *testing.T) {
+	stackName := fmt.Sprintf("tf-%d", acctest.RandInt())
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsOpsworksEcsClusterLayerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsOpsworksEcsClusterLayerConfigVpcCreate(stackName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"aws_opsworks_ecs_cluster_layer.tf-acc", "name", stackName,
+					),
+					resource.TestCheckResourceAttrPair(
+						"aws_opsworks_ecs_cluster_layer.tf-acc", "ecs_cluster_arn",
+						"aws_ecs_cluster.tf-acc", "id",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsOpsworksEcsClusterLayerDestroy(s *terraform.State) error {
+	opsworksconn := testAccProvider.Meta().(*AWSClient).opsworksconn
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_opsworks_ecs_cluster_layer" {
+			continue
+		}
+
+		_, err := opsworksconn.DescribeLayers(&opsworks.DescribeLayersInput{
+			LayerIds: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err == nil {
+			return fmt.Errorf("OpsWorks ECS cluster layer %q still exists", rs.Primary.ID)
+		}
+		if !isAWSErr(err, opsworks.ErrCodeResourceNotFoundException, "") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsOpsworksEcsClusterLayerConfigVpcCreate(name string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+	region = "us-west-2"
+}
+
+resource "aws_ecs_cluster" "tf-acc" {
+  name = "%s"
+}
+
+resource "aws_opsworks_ecs_cluster_layer" "tf-acc" {
+  stack_id = "${aws_opsworks_stack.tf-acc.id}"
+  name = "%s"
+  ecs_cluster_arn = "${aws_ecs_cluster.tf-acc.id}"
+  custom_security_group_ids = [
+    "${aws_security_group.tf-ops-acc-layer1.id}",
+    "${aws_security_group.tf-ops-acc-layer2.id}",
+  ]
+}
+
+%s
+
+%s
+
+`, name, name, testAccAwsOpsworksStackConfigVpcCreate(name), testAccAwsOpsworksCustomLayerSecurityGroups(name))
+}
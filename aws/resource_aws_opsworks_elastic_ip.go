@@ -0,0 +1,170 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opsworks"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsOpsworksElasticIp() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsOpsworksElasticIpRegister,
+		Read:   resourceAwsOpsworksElasticIpRead,
+		Update: resourceAwsOpsworksElasticIpUpdate,
+		Delete: resourceAwsOpsworksElasticIpDeregister,
+
+		Schema: map[string]*schema.Schema{
+			"stack_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"elastic_ip": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsOpsworksElasticIpRegister(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AWSClient).opsworksconn
+
+	req := &opsworks.RegisterElasticIpInput{
+		ElasticIp: aws.String(d.Get("elastic_ip").(string)),
+		StackId:   aws.String(d.Get("stack_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Registering Elastic IP %s with OpsWorks stack: %s", d.Get("elastic_ip"), d.Get("stack_id"))
+	resp, err := client.RegisterElasticIp(req)
+	if err != nil {
+		return fmt.Errorf("Error registering OpsWorks Elastic IP: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.ElasticIp))
+
+	return resourceAwsOpsworksElasticIpUpdate(d, meta)
+}
+
+func resourceAwsOpsworksElasticIpUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AWSClient).opsworksconn
+
+	d.Partial(true)
+
+	if v, ok := d.GetOk("name"); ok && d.HasChange("name") {
+		log.Printf("[DEBUG] Updating OpsWorks Elastic IP %s name: %s", d.Id(), v)
+		_, err := client.UpdateElasticIp(&opsworks.UpdateElasticIpInput{
+			ElasticIp: aws.String(d.Id()),
+			Name:      aws.String(v.(string)),
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating OpsWorks Elastic IP (%s): %s", d.Id(), err)
+		}
+		d.SetPartial("name")
+	}
+
+	if d.HasChange("instance_id") {
+		o, n := d.GetChange("instance_id")
+
+		if o.(string) != "" {
+			log.Printf("[DEBUG] Disassociating OpsWorks Elastic IP %s from instance: %s", d.Id(), o)
+			_, err := client.DisassociateElasticIp(&opsworks.DisassociateElasticIpInput{
+				ElasticIp: aws.String(d.Id()),
+			})
+			if err != nil {
+				return fmt.Errorf("Error disassociating OpsWorks Elastic IP (%s): %s", d.Id(), err)
+			}
+		}
+
+		if n.(string) != "" {
+			log.Printf("[DEBUG] Associating OpsWorks Elastic IP %s with instance: %s", d.Id(), n)
+			_, err := client.AssociateElasticIp(&opsworks.AssociateElasticIpInput{
+				ElasticIp:  aws.String(d.Id()),
+				InstanceId: aws.String(n.(string)),
+			})
+			if err != nil {
+				return fmt.Errorf("Error associating OpsWorks Elastic IP (%s): %s", d.Id(), err)
+			}
+		}
+		d.SetPartial("instance_id")
+	}
+
+	d.Partial(false)
+
+	return resourceAwsOpsworksElasticIpRead(d, meta)
+}
+
+func resourceAwsOpsworksElasticIpRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AWSClient).opsworksconn
+
+	log.Printf("[DEBUG] Reading OpsWorks Elastic IP: %s", d.Id())
+	resp, err := client.DescribeElasticIps(&opsworks.DescribeElasticIpsInput{
+		Ips: []*string{aws.String(d.Id())},
+	})
+	if err != nil {
+		if isAWSErr(err, opsworks.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] OpsWorks Elastic IP (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading OpsWorks Elastic IP (%s): %s", d.Id(), err)
+	}
+
+	if len(resp.ElasticIps) == 0 {
+		log.Printf("[WARN] OpsWorks Elastic IP (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	eip := resp.ElasticIps[0]
+	d.Set("elastic_ip", eip.Ip)
+	d.Set("name", eip.Name)
+	d.Set("instance_id", eip.InstanceId)
+	d.Set("domain", eip.Domain)
+
+	return nil
+}
+
+func resourceAwsOpsworksElasticIpDeregister(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AWSClient).opsworksconn
+
+	if v, ok := d.GetOk("instance_id"); ok && v.(string) != "" {
+		log.Printf("[DEBUG] Disassociating OpsWorks Elastic IP %s from instance: %s", d.Id(), v)
+		_, err := client.DisassociateElasticIp(&opsworks.DisassociateElasticIpInput{
+			ElasticIp: aws.String(d.Id()),
+		})
+		if err != nil && !isAWSErr(err, opsworks.ErrCodeResourceNotFoundException, "") {
+			return fmt.Errorf("Error disassociating OpsWorks Elastic IP (%s): %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deregistering OpsWorks Elastic IP: %s", d.Id())
+	_, err := client.DeregisterElasticIp(&opsworks.DeregisterElasticIpInput{
+		ElasticIp: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, opsworks.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deregistering OpsWorks Elastic IP (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
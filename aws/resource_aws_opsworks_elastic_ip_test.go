@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opsworks"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSOpsworksElasticIp(t *testing.T) {
+	stackName := fmt.Sprintf("tf-%d", acctest.RandInt())
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsOpsworksElasticIpDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsOpsworksElasticIpConfig(stackName, "tf-acc-eip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOpsworksElasticIpExists("aws_opsworks_elastic_ip.tf-acc"),
+					resource.TestCheckResourceAttr("aws_opsworks_elastic_ip.tf-acc", "name", "tf-acc-eip"),
+					resource.TestCheckResourceAttr("aws_opsworks_elastic_ip.tf-acc", "domain", "vpc"),
+				),
+			},
+			{
+				Config: testAccAwsOpsworksElasticIpConfig(stackName, "tf-acc-eip-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOpsworksElasticIpExists("aws_opsworks_elastic_ip.tf-acc"),
+					resource.TestCheckResourceAttr("aws_opsworks_elastic_ip.tf-acc", "name", "tf-acc-eip-updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsOpsworksElasticIpExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No OpsWorks Elastic IP is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).opsworksconn
+		resp, err := conn.DescribeElasticIps(&opsworks.DescribeElasticIpsInput{
+			Ips: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.ElasticIps) != 1 {
+			return fmt.Errorf("OpsWorks Elastic IP %q not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsOpsworksElasticIpDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).opsworksconn
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_opsworks_elastic_ip" {
+			continue
+		}
+
+		resp, err := conn.DescribeElasticIps(&opsworks.DescribeElasticIpsInput{
+			Ips: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			if isAWSErr(err, opsworks.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		if len(resp.ElasticIps) > 0 {
+			return fmt.Errorf("OpsWorks Elastic IP %q still registered", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsOpsworksElasticIpConfig(stackName, name string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+	region = "us-west-2"
+}
+
+resource "aws_eip" "tf-acc" {
+  vpc = true
+}
+
+resource "aws_opsworks_elastic_ip" "tf-acc" {
+  stack_id = "${aws_opsworks_stack.tf-acc.id}"
+  elastic_ip = "${aws_eip.tf-acc.public_ip}"
+  name = "%s"
+}
+
+%s
+`, name, testAccAwsOpsworksStackConfigVpcCreate(stackName))
+}
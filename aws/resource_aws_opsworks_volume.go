@@ -0,0 +1,191 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opsworks"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsOpsworksVolume() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsOpsworksVolumeRegister,
+		Read:   resourceAwsOpsworksVolumeRead,
+		Update: resourceAwsOpsworksVolumeUpdate,
+		Delete: resourceAwsOpsworksVolumeDeregister,
+
+		Schema: map[string]*schema.Schema{
+			"stack_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ec2_volume_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"mount_point": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"device": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsOpsworksVolumeRegister(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AWSClient).opsworksconn
+
+	req := &opsworks.RegisterVolumeInput{
+		Ec2VolumeId: aws.String(d.Get("ec2_volume_id").(string)),
+		StackId:     aws.String(d.Get("stack_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Registering volume %s with OpsWorks stack: %s", d.Get("ec2_volume_id"), d.Get("stack_id"))
+	resp, err := client.RegisterVolume(req)
+	if err != nil {
+		return fmt.Errorf("Error registering OpsWorks volume: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.VolumeId))
+
+	return resourceAwsOpsworksVolumeUpdate(d, meta)
+}
+
+func resourceAwsOpsworksVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AWSClient).opsworksconn
+
+	d.Partial(true)
+
+	if d.HasChange("name") || d.HasChange("mount_point") {
+		req := &opsworks.UpdateVolumeInput{
+			VolumeId: aws.String(d.Id()),
+		}
+		if v, ok := d.GetOk("name"); ok {
+			req.Name = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("mount_point"); ok {
+			req.MountPoint = aws.String(v.(string))
+		}
+
+		if req.Name != nil || req.MountPoint != nil {
+			log.Printf("[DEBUG] Updating OpsWorks volume: %s", req)
+			_, err := client.UpdateVolume(req)
+			if err != nil {
+				return fmt.Errorf("Error updating OpsWorks volume (%s): %s", d.Id(), err)
+			}
+		}
+		d.SetPartial("name")
+		d.SetPartial("mount_point")
+	}
+
+	if d.HasChange("instance_id") {
+		o, n := d.GetChange("instance_id")
+
+		if o.(string) != "" {
+			log.Printf("[DEBUG] Unassigning OpsWorks volume %s from instance: %s", d.Id(), o)
+			_, err := client.UnassignVolume(&opsworks.UnassignVolumeInput{
+				VolumeId: aws.String(d.Id()),
+			})
+			if err != nil {
+				return fmt.Errorf("Error unassigning OpsWorks volume (%s): %s", d.Id(), err)
+			}
+		}
+
+		if n.(string) != "" {
+			log.Printf("[DEBUG] Assigning OpsWorks volume %s to instance: %s", d.Id(), n)
+			_, err := client.AssignVolume(&opsworks.AssignVolumeInput{
+				VolumeId:   aws.String(d.Id()),
+				InstanceId: aws.String(n.(string)),
+			})
+			if err != nil {
+				return fmt.Errorf("Error assigning OpsWorks volume (%s): %s", d.Id(), err)
+			}
+		}
+		d.SetPartial("instance_id")
+	}
+
+	d.Partial(false)
+
+	return resourceAwsOpsworksVolumeRead(d, meta)
+}
+
+func resourceAwsOpsworksVolumeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AWSClient).opsworksconn
+
+	log.Printf("[DEBUG] Reading OpsWorks volume: %s", d.Id())
+	resp, err := client.DescribeVolumes(&opsworks.DescribeVolumesInput{
+		VolumeIds: []*string{aws.String(d.Id())},
+	})
+	if err != nil {
+		if isAWSErr(err, opsworks.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] OpsWorks volume (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading OpsWorks volume (%s): %s", d.Id(), err)
+	}
+
+	if len(resp.Volumes) == 0 {
+		log.Printf("[WARN] OpsWorks volume (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	volume := resp.Volumes[0]
+	d.Set("ec2_volume_id", volume.Ec2VolumeId)
+	d.Set("name", volume.Name)
+	d.Set("mount_point", volume.MountPoint)
+	d.Set("instance_id", volume.InstanceId)
+	d.Set("device", volume.Device)
+	d.Set("status", volume.Status)
+
+	return nil
+}
+
+func resourceAwsOpsworksVolumeDeregister(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AWSClient).opsworksconn
+
+	if v, ok := d.GetOk("instance_id"); ok && v.(string) != "" {
+		log.Printf("[DEBUG] Unassigning OpsWorks volume %s from instance: %s", d.Id(), v)
+		_, err := client.UnassignVolume(&opsworks.UnassignVolumeInput{
+			VolumeId: aws.String(d.Id()),
+		})
+		if err != nil && !isAWSErr(err, opsworks.ErrCodeResourceNotFoundException, "") {
+			return fmt.Errorf("Error unassigning OpsWorks volume (%s): %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deregistering OpsWorks volume: %s", d.Id())
+	_, err := client.DeregisterVolume(&opsworks.DeregisterVolumeInput{
+		VolumeId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, opsworks.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deregistering OpsWorks volume (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
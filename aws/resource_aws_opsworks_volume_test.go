@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opsworks"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSOpsworksVolume(t *testing.T) {
+	stackName := fmt.Sprintf("tf-%d", acctest.RandInt())
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsOpsworksVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsOpsworksVolumeConfig(stackName, "/data"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOpsworksVolumeExists("aws_opsworks_volume.tf-acc"),
+					resource.TestCheckResourceAttrPair(
+						"aws_opsworks_volume.tf-acc", "ec2_volume_id",
+						"aws_ebs_volume.tf-acc", "id",
+					),
+					resource.TestCheckResourceAttr("aws_opsworks_volume.tf-acc", "mount_point", "/data"),
+				),
+			},
+			{
+				Config: testAccAwsOpsworksVolumeConfig(stackName, "/srv/data"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOpsworksVolumeExists("aws_opsworks_volume.tf-acc"),
+					resource.TestCheckResourceAttr("aws_opsworks_volume.tf-acc", "mount_point", "/srv/data"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsOpsworksVolumeExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No OpsWorks volume ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).opsworksconn
+		resp, err := conn.DescribeVolumes(&opsworks.DescribeVolumesInput{
+			VolumeIds: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.Volumes) != 1 {
+			return fmt.Errorf("OpsWorks volume %q not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsOpsworksVolumeDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).opsworksconn
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_opsworks_volume" {
+			continue
+		}
+
+		resp, err := conn.DescribeVolumes(&opsworks.DescribeVolumesInput{
+			VolumeIds: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			if isAWSErr(err, opsworks.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		if len(resp.Volumes) > 0 {
+			return fmt.Errorf("OpsWorks volume %q still registered", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsOpsworksVolumeConfig(stackName, mountPoint string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+	region = "us-west-2"
+}
+
+resource "aws_ebs_volume" "tf-acc" {
+  availability_zone = "us-west-2a"
+  size = 1
+}
+
+resource "aws_opsworks_volume" "tf-acc" {
+  stack_id = "${aws_opsworks_stack.tf-acc.id}"
+  ec2_volume_id = "${aws_ebs_volume.tf-acc.id}"
+  name = "tf-acc-data"
+  mount_point = "%s"
+}
+
+%s
+`, mountPoint, testAccAwsOpsworksStackConfigVpcCreate(stackName))
+}
@@ -779,19 +779,10 @@ func resourceAwsRedshiftClusterUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	if d.HasChange("snapshot_copy") {
-		if v, ok := d.GetOk("snapshot_copy"); ok {
-			err := enableRedshiftSnapshotCopy(d.Id(), v.([]interface{}), conn)
-			if err != nil {
-				return err
-			}
-		} else {
-			_, err := conn.DisableSnapshotCopy(&redshift.DisableSnapshotCopyInput{
-				ClusterIdentifier: aws.String(d.Id()),
-			})
-			if err != nil {
-				return fmt.Errorf("Failed to disable snapshot copy: %s", err)
-			}
+		if err := updateRedshiftSnapshotCopy(d, conn); err != nil {
+			return err
 		}
+		d.SetPartial("snapshot_copy")
 	}
 
 	deprecatedHasChange := (d.HasChange("enable_logging") || d.HasChange("bucket_name") || d.HasChange("s3_key_prefix"))
@@ -871,7 +862,7 @@ func enableRedshiftSnapshotCopy(id string, scList []interface{}, conn *redshift.
 	if rp, ok := sc["retention_period"]; ok {
 		input.RetentionPeriod = aws.Int64(int64(rp.(int)))
 	}
-	if gn, ok := sc["grant_name"]; ok {
+	if gn, ok := sc["grant_name"]; ok && gn.(string) != "" {
 		input.SnapshotCopyGrantName = aws.String(gn.(string))
 	}
 
@@ -882,6 +873,60 @@ func enableRedshiftSnapshotCopy(id string, scList []interface{}, conn *redshift.
 	return nil
 }
 
+// updateRedshiftSnapshotCopy reconciles cross-region snapshot copy with the
+// snapshot_copy block. A retention period change is applied in place; a new
+// destination region or grant requires copy to be disabled and re-enabled.
+func updateRedshiftSnapshotCopy(d *schema.ResourceData, conn *redshift.Redshift) error {
+	o, n := d.GetChange("snapshot_copy")
+	oldList := o.([]interface{})
+	newList := n.([]interface{})
+
+	if len(newList) == 0 {
+		if len(oldList) == 0 {
+			return nil
+		}
+		return disableRedshiftSnapshotCopy(d.Id(), conn)
+	}
+
+	if len(oldList) == 0 {
+		return enableRedshiftSnapshotCopy(d.Id(), newList, conn)
+	}
+
+	oldSc := oldList[0].(map[string]interface{})
+	newSc := newList[0].(map[string]interface{})
+
+	if oldSc["destination_region"].(string) != newSc["destination_region"].(string) ||
+		oldSc["grant_name"].(string) != newSc["grant_name"].(string) {
+		if err := disableRedshiftSnapshotCopy(d.Id(), conn); err != nil {
+			return err
+		}
+		return enableRedshiftSnapshotCopy(d.Id(), newList, conn)
+	}
+
+	if oldSc["retention_period"].(int) != newSc["retention_period"].(int) {
+		log.Printf("[DEBUG] Modifying Redshift Cluster (%s) snapshot copy retention period", d.Id())
+		_, err := conn.ModifySnapshotCopyRetentionPeriod(&redshift.ModifySnapshotCopyRetentionPeriodInput{
+			ClusterIdentifier: aws.String(d.Id()),
+			RetentionPeriod:   aws.Int64(int64(newSc["retention_period"].(int))),
+		})
+		if err != nil {
+			return fmt.Errorf("Failed to modify snapshot copy retention period: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func disableRedshiftSnapshotCopy(id string, conn *redshift.Redshift) error {
+	_, err := conn.DisableSnapshotCopy(&redshift.DisableSnapshotCopyInput{
+		ClusterIdentifier: aws.String(id),
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to disable snapshot copy: %s", err)
+	}
+	return nil
+}
+
 func resourceAwsRedshiftClusterDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).redshiftconn
 	log.Printf("[DEBUG] Destroying Redshift Cluster (%s)", d.Id())
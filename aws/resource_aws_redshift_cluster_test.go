@@ -231,7 +231,7 @@ func TestAccAWSRedshiftCluster_snapshotCopy(t *testing.T) {
 		CheckDestroy: testAccCheckAWSRedshiftClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAWSRedshiftClusterConfig_snapshotCopyEnabled(rInt),
+				Config: testAccAWSRedshiftClusterConfig_snapshotCopyEnabled(rInt, "us-east-1", 1),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.default", &v),
 					resource.TestCheckResourceAttr(
@@ -241,6 +241,28 @@ func TestAccAWSRedshiftCluster_snapshotCopy(t *testing.T) {
 				),
 			},
 
+			{
+				Config: testAccAWSRedshiftClusterConfig_snapshotCopyEnabled(rInt, "us-east-1", 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.default", &v),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_cluster.default", "snapshot_copy.0.destination_region", "us-east-1"),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_cluster.default", "snapshot_copy.0.retention_period", "3"),
+				),
+			},
+
+			{
+				Config: testAccAWSRedshiftClusterConfig_snapshotCopyEnabled(rInt, "us-east-2", 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.default", &v),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_cluster.default", "snapshot_copy.0.destination_region", "us-east-2"),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_cluster.default", "snapshot_copy.0.retention_period", "3"),
+				),
+			},
+
 			{
 				Config: testAccAWSRedshiftClusterConfig_snapshotCopyDisabled(rInt),
 				Check: resource.ComposeTestCheckFunc(
@@ -953,7 +975,7 @@ func testAccAWSRedshiftClusterConfig_snapshotCopyDisabled(rInt int) string {
 	}`, rInt)
 }
 
-func testAccAWSRedshiftClusterConfig_snapshotCopyEnabled(rInt int) string {
+func testAccAWSRedshiftClusterConfig_snapshotCopyEnabled(rInt int, destinationRegion string, retentionPeriod int) string {
 	return fmt.Sprintf(`
  resource "aws_redshift_cluster" "default" {
 	 cluster_identifier = "tf-redshift-cluster-%d"
@@ -965,11 +987,11 @@ func testAccAWSRedshiftClusterConfig_snapshotCopyEnabled(rInt int) string {
 	 automated_snapshot_retention_period = 0
 	 allow_version_upgrade = false
 	 snapshot_copy {
-		destination_region = "us-east-1"
-		retention_period = 1
+		destination_region = "%s"
+		retention_period = %d
 	 }
 	 skip_final_snapshot = true
- }`, rInt)
+ }`, rInt, destinationRegion, retentionPeriod)
 }
 
 func testAccAWSRedshiftClusterConfig_tags(rInt int) string {
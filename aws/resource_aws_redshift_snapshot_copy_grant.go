@@ -0,0 +1,172 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsRedshiftSnapshotCopyGrant() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRedshiftSnapshotCopyGrantCreate,
+		Read:   resourceAwsRedshiftSnapshotCopyGrantRead,
+		Update: resourceAwsRedshiftSnapshotCopyGrantUpdate,
+		Delete: resourceAwsRedshiftSnapshotCopyGrantDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"snapshot_copy_grant_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validateArn,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsRedshiftSnapshotCopyGrantCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	grantName := d.Get("snapshot_copy_grant_name").(string)
+
+	input := &redshift.CreateSnapshotCopyGrantInput{
+		SnapshotCopyGrantName: aws.String(grantName),
+		Tags:                  tagsFromMapRedshift(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Redshift snapshot copy grant: %s", input)
+	_, err := conn.CreateSnapshotCopyGrant(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Redshift snapshot copy grant %q: %s", grantName, err)
+	}
+
+	d.SetId(grantName)
+
+	// The grant is not always immediately visible after creation.
+	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+		grant, err := findAwsRedshiftSnapshotCopyGrant(conn, grantName)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if grant == nil {
+			return resource.RetryableError(fmt.Errorf("Redshift snapshot copy grant %q not yet visible", grantName))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceAwsRedshiftSnapshotCopyGrantRead(d, meta)
+}
+
+func resourceAwsRedshiftSnapshotCopyGrantRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	grant, err := findAwsRedshiftSnapshotCopyGrant(conn, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if grant == nil {
+		log.Printf("[WARN] Redshift snapshot copy grant (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	arn, err := buildRedshiftSnapshotCopyGrantARN(d.Id(), meta.(*AWSClient).partition, meta.(*AWSClient).accountid, meta.(*AWSClient).region)
+	if err != nil {
+		return err
+	}
+
+	d.Set("arn", arn)
+	d.Set("snapshot_copy_grant_name", grant.SnapshotCopyGrantName)
+	d.Set("kms_key_id", grant.KmsKeyId)
+	if err := d.Set("tags", tagsToMapRedshift(grant.Tags)); err != nil {
+		return fmt.Errorf("Error setting Redshift snapshot copy grant tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsRedshiftSnapshotCopyGrantUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	if err := setTagsRedshift(conn, d, d.Get("arn").(string)); err != nil {
+		return err
+	}
+
+	return resourceAwsRedshiftSnapshotCopyGrantRead(d, meta)
+}
+
+func resourceAwsRedshiftSnapshotCopyGrantDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	log.Printf("[DEBUG] Deleting Redshift snapshot copy grant: %s", d.Id())
+	_, err := conn.DeleteSnapshotCopyGrant(&redshift.DeleteSnapshotCopyGrantInput{
+		SnapshotCopyGrantName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, redshift.ErrCodeSnapshotCopyGrantNotFoundFault, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Redshift snapshot copy grant %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// findAwsRedshiftSnapshotCopyGrant returns the named grant, or nil if it does not exist.
+func findAwsRedshiftSnapshotCopyGrant(conn *redshift.Redshift, grantName string) (*redshift.SnapshotCopyGrant, error) {
+	resp, err := conn.DescribeSnapshotCopyGrants(&redshift.DescribeSnapshotCopyGrantsInput{
+		SnapshotCopyGrantName: aws.String(grantName),
+	})
+	if err != nil {
+		if isAWSErr(err, redshift.ErrCodeSnapshotCopyGrantNotFoundFault, "") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Error reading Redshift snapshot copy grant %q: %s", grantName, err)
+	}
+
+	for _, grant := range resp.SnapshotCopyGrants {
+		if aws.StringValue(grant.SnapshotCopyGrantName) == grantName {
+			return grant, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func buildRedshiftSnapshotCopyGrantARN(identifier, partition, accountid, region string) (string, error) {
+	if partition == "" {
+		return "", fmt.Errorf("Unable to construct Snapshot Copy Grant ARN because of missing AWS partition")
+	}
+	if accountid == "" {
+		return "", fmt.Errorf("Unable to construct Snapshot Copy Grant ARN because of missing AWS Account ID")
+	}
+	arn := fmt.Sprintf("arn:%s:redshift:%s:%s:snapshotcopygrant:%s", partition, region, accountid, identifier)
+	return arn, nil
+}
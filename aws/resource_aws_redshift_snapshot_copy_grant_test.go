@@ -0,0 +1,156 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSRedshiftSnapshotCopyGrant_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_redshift_snapshot_copy_grant.basic"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRedshiftSnapshotCopyGrantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRedshiftSnapshotCopyGrant_Basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftSnapshotCopyGrantExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_copy_grant_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "kms_key_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "tf-redshift-snapshot-copy-grant"),
+				),
+			},
+			{
+				Config: testAccAWSRedshiftSnapshotCopyGrant_tagsUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftSnapshotCopyGrantExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Env", "test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRedshiftSnapshotCopyGrant_kmsKey(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_redshift_snapshot_copy_grant.basic"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRedshiftSnapshotCopyGrantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRedshiftSnapshotCopyGrant_kmsKey(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftSnapshotCopyGrantExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", "aws_kms_key.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSRedshiftSnapshotCopyGrantDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_redshift_snapshot_copy_grant" {
+			continue
+		}
+
+		grant, err := findAwsRedshiftSnapshotCopyGrant(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if grant != nil {
+			return fmt.Errorf("Redshift snapshot copy grant %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSRedshiftSnapshotCopyGrantExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Redshift snapshot copy grant ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+		resp, err := conn.DescribeSnapshotCopyGrants(&redshift.DescribeSnapshotCopyGrantsInput{
+			SnapshotCopyGrantName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.SnapshotCopyGrants) == 0 {
+			return fmt.Errorf("Redshift snapshot copy grant %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSRedshiftSnapshotCopyGrant_Basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_snapshot_copy_grant" "basic" {
+  snapshot_copy_grant_name = "%s"
+
+  tags {
+    Name = "tf-redshift-snapshot-copy-grant"
+  }
+}
+`, rName)
+}
+
+func testAccAWSRedshiftSnapshotCopyGrant_tagsUpdated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_snapshot_copy_grant" "basic" {
+  snapshot_copy_grant_name = "%s"
+
+  tags {
+    Name = "tf-redshift-snapshot-copy-grant"
+    Env  = "test"
+  }
+}
+`, rName)
+}
+
+func testAccAWSRedshiftSnapshotCopyGrant_kmsKey(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = "%s"
+  deletion_window_in_days = 7
+}
+
+resource "aws_redshift_snapshot_copy_grant" "basic" {
+  snapshot_copy_grant_name = "%s"
+  kms_key_id               = "${aws_kms_key.test.arn}"
+}
+`, rName, rName)
+}
@@ -0,0 +1,246 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsRoute53TrafficPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRoute53TrafficPolicyCreate,
+		Read:   resourceAwsRoute53TrafficPolicyRead,
+		Update: resourceAwsRoute53TrafficPolicyUpdate,
+		Delete: resourceAwsRoute53TrafficPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			if diff.HasChange("document") {
+				o, n := diff.GetChange("document")
+				if !suppressEquivalentJsonDiffs("document", o.(string), n.(string), nil) {
+					return diff.SetNewComputed("version")
+				}
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"comment": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"document": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				ValidateFunc:     validateJsonString,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsRoute53TrafficPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	input := &route53.CreateTrafficPolicyInput{
+		Name:     aws.String(d.Get("name").(string)),
+		Document: aws.String(d.Get("document").(string)),
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		input.Comment = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Route53 traffic policy: %s", input)
+	resp, err := conn.CreateTrafficPolicy(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Route53 traffic policy: %s", err)
+	}
+
+	d.SetId(*resp.TrafficPolicy.Id)
+
+	return resourceAwsRoute53TrafficPolicyRead(d, meta)
+}
+
+func resourceAwsRoute53TrafficPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	versions, err := listRoute53TrafficPolicyVersions(conn, d.Id())
+	if err != nil {
+		if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicy, "") {
+			log.Printf("[WARN] Route53 traffic policy (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Route53 traffic policy (%s): %s", d.Id(), err)
+	}
+
+	if len(versions) == 0 {
+		log.Printf("[WARN] Route53 traffic policy (%s) has no versions, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	latest := latestRoute53TrafficPolicyVersion(versions)
+
+	d.Set("name", latest.Name)
+	d.Set("comment", latest.Comment)
+	d.Set("document", latest.Document)
+	d.Set("type", latest.Type)
+	d.Set("version", latest.Version)
+
+	return nil
+}
+
+func resourceAwsRoute53TrafficPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	if d.HasChange("document") {
+		input := &route53.CreateTrafficPolicyVersionInput{
+			Id:       aws.String(d.Id()),
+			Document: aws.String(d.Get("document").(string)),
+		}
+
+		if v, ok := d.GetOk("comment"); ok {
+			input.Comment = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Creating Route53 traffic policy version: %s", input)
+		resp, err := conn.CreateTrafficPolicyVersion(input)
+		if err != nil {
+			return fmt.Errorf("Error creating Route53 traffic policy (%s) version: %s", d.Id(), err)
+		}
+
+		if err := deleteRoute53TrafficPolicyVersionsExcept(conn, d.Id(), *resp.TrafficPolicy.Version); err != nil {
+			return err
+		}
+	} else if d.HasChange("comment") {
+		input := &route53.UpdateTrafficPolicyCommentInput{
+			Id:      aws.String(d.Id()),
+			Version: aws.Int64(int64(d.Get("version").(int))),
+			Comment: aws.String(d.Get("comment").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Route53 traffic policy comment: %s", input)
+		_, err := conn.UpdateTrafficPolicyComment(input)
+		if err != nil {
+			return fmt.Errorf("Error updating Route53 traffic policy (%s) comment: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsRoute53TrafficPolicyRead(d, meta)
+}
+
+func resourceAwsRoute53TrafficPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	versions, err := listRoute53TrafficPolicyVersions(conn, d.Id())
+	if err != nil {
+		if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicy, "") {
+			return nil
+		}
+		return fmt.Errorf("Error reading Route53 traffic policy (%s): %s", d.Id(), err)
+	}
+
+	for _, version := range versions {
+		log.Printf("[DEBUG] Deleting Route53 traffic policy (%s) version %d", d.Id(), *version.Version)
+		_, err := conn.DeleteTrafficPolicy(&route53.DeleteTrafficPolicyInput{
+			Id:      version.Id,
+			Version: version.Version,
+		})
+		if err != nil {
+			if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicy, "") {
+				continue
+			}
+			return fmt.Errorf("Error deleting Route53 traffic policy (%s) version %d: %s", d.Id(), *version.Version, err)
+		}
+	}
+
+	return nil
+}
+
+func listRoute53TrafficPolicyVersions(conn *route53.Route53, id string) ([]*route53.TrafficPolicy, error) {
+	var versions []*route53.TrafficPolicy
+
+	input := &route53.ListTrafficPolicyVersionsInput{
+		Id: aws.String(id),
+	}
+
+	for {
+		resp, err := conn.ListTrafficPolicyVersions(input)
+		if err != nil {
+			return nil, err
+		}
+
+		versions = append(versions, resp.TrafficPolicies...)
+
+		if !aws.BoolValue(resp.IsTruncated) {
+			break
+		}
+		input.TrafficPolicyVersionMarker = resp.TrafficPolicyVersionMarker
+	}
+
+	return versions, nil
+}
+
+func latestRoute53TrafficPolicyVersion(versions []*route53.TrafficPolicy) *route53.TrafficPolicy {
+	var latest *route53.TrafficPolicy
+	for _, version := range versions {
+		if latest == nil || aws.Int64Value(version.Version) > aws.Int64Value(latest.Version) {
+			latest = version
+		}
+	}
+	return latest
+}
+
+// deleteRoute53TrafficPolicyVersionsExcept removes all but the given version
+// of a traffic policy. Versions still referenced by a traffic policy instance
+// cannot be deleted and are kept until a later update or destroy.
+func deleteRoute53TrafficPolicyVersionsExcept(conn *route53.Route53, id string, keep int64) error {
+	versions, err := listRoute53TrafficPolicyVersions(conn, id)
+	if err != nil {
+		return fmt.Errorf("Error reading Route53 traffic policy (%s): %s", id, err)
+	}
+
+	for _, version := range versions {
+		if aws.Int64Value(version.Version) == keep {
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting Route53 traffic policy (%s) version %d", id, *version.Version)
+		_, err := conn.DeleteTrafficPolicy(&route53.DeleteTrafficPolicyInput{
+			Id:      version.Id,
+			Version: version.Version,
+		})
+		if err != nil {
+			if isAWSErr(err, route53.ErrCodeTrafficPolicyInUse, "") {
+				log.Printf("[WARN] Route53 traffic policy (%s) version %d is in use, not deleting", id, *version.Version)
+				continue
+			}
+			if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicy, "") {
+				continue
+			}
+			return fmt.Errorf("Error deleting Route53 traffic policy (%s) version %d: %s", id, *version.Version, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,206 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsRoute53TrafficPolicyInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRoute53TrafficPolicyInstanceCreate,
+		Read:   resourceAwsRoute53TrafficPolicyInstanceRead,
+		Update: resourceAwsRoute53TrafficPolicyInstanceUpdate,
+		Delete: resourceAwsRoute53TrafficPolicyInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.TrimSuffix(old, ".") == strings.TrimSuffix(new, ".")
+				},
+			},
+			"ttl": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"traffic_policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"traffic_policy_version": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsRoute53TrafficPolicyInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	input := &route53.CreateTrafficPolicyInstanceInput{
+		HostedZoneId:         aws.String(d.Get("zone_id").(string)),
+		Name:                 aws.String(d.Get("name").(string)),
+		TTL:                  aws.Int64(int64(d.Get("ttl").(int))),
+		TrafficPolicyId:      aws.String(d.Get("traffic_policy_id").(string)),
+		TrafficPolicyVersion: aws.Int64(int64(d.Get("traffic_policy_version").(int))),
+	}
+
+	log.Printf("[DEBUG] Creating Route53 traffic policy instance: %s", input)
+	resp, err := conn.CreateTrafficPolicyInstance(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Route53 traffic policy instance: %s", err)
+	}
+
+	d.SetId(*resp.TrafficPolicyInstance.Id)
+
+	if err := waitForRoute53TrafficPolicyInstanceApplied(conn, d.Id(), []string{"Creating"}, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceAwsRoute53TrafficPolicyInstanceRead(d, meta)
+}
+
+func resourceAwsRoute53TrafficPolicyInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	resp, err := conn.GetTrafficPolicyInstance(&route53.GetTrafficPolicyInstanceInput{
+		Id: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicyInstance, "") {
+			log.Printf("[WARN] Route53 traffic policy instance (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Route53 traffic policy instance (%s): %s", d.Id(), err)
+	}
+
+	instance := resp.TrafficPolicyInstance
+
+	d.Set("zone_id", cleanZoneID(aws.StringValue(instance.HostedZoneId)))
+	d.Set("name", strings.TrimSuffix(aws.StringValue(instance.Name), "."))
+	d.Set("ttl", instance.TTL)
+	d.Set("traffic_policy_id", instance.TrafficPolicyId)
+	d.Set("traffic_policy_version", instance.TrafficPolicyVersion)
+	d.Set("state", instance.State)
+
+	return nil
+}
+
+func resourceAwsRoute53TrafficPolicyInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	input := &route53.UpdateTrafficPolicyInstanceInput{
+		Id:                   aws.String(d.Id()),
+		TTL:                  aws.Int64(int64(d.Get("ttl").(int))),
+		TrafficPolicyId:      aws.String(d.Get("traffic_policy_id").(string)),
+		TrafficPolicyVersion: aws.Int64(int64(d.Get("traffic_policy_version").(int))),
+	}
+
+	log.Printf("[DEBUG] Updating Route53 traffic policy instance: %s", input)
+	_, err := conn.UpdateTrafficPolicyInstance(input)
+	if err != nil {
+		return fmt.Errorf("Error updating Route53 traffic policy instance (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForRoute53TrafficPolicyInstanceApplied(conn, d.Id(), []string{"Updating"}, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	return resourceAwsRoute53TrafficPolicyInstanceRead(d, meta)
+}
+
+func resourceAwsRoute53TrafficPolicyInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	log.Printf("[DEBUG] Deleting Route53 traffic policy instance: %s", d.Id())
+	_, err := conn.DeleteTrafficPolicyInstance(&route53.DeleteTrafficPolicyInstanceInput{
+		Id: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicyInstance, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Route53 traffic policy instance (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Applied", "Deleting"},
+		Target:     []string{""},
+		Refresh:    route53TrafficPolicyInstanceStateRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Route53 traffic policy instance (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func waitForRoute53TrafficPolicyInstanceApplied(conn *route53.Route53, id string, pending []string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     []string{"Applied"},
+		Refresh:    route53TrafficPolicyInstanceStateRefreshFunc(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Route53 traffic policy instance (%s) to be applied: %s", id, err)
+	}
+
+	return nil
+}
+
+func route53TrafficPolicyInstanceStateRefreshFunc(conn *route53.Route53, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.GetTrafficPolicyInstance(&route53.GetTrafficPolicyInstanceInput{
+			Id: aws.String(id),
+		})
+		if err != nil {
+			if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicyInstance, "") {
+				return 42, "", nil
+			}
+			return nil, "", err
+		}
+
+		instance := resp.TrafficPolicyInstance
+		state := aws.StringValue(instance.State)
+		if state == "Failed" {
+			return instance, state, fmt.Errorf("Route53 traffic policy instance (%s) failed: %s", id, aws.StringValue(instance.Message))
+		}
+
+		return instance, state, nil
+	}
+}
@@ -0,0 +1,112 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSRoute53TrafficPolicyInstance_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	zoneName := fmt.Sprintf("%s.com", rName)
+	resourceName := "aws_route53_traffic_policy_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53TrafficPolicyInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53TrafficPolicyInstanceConfig(rName, zoneName, "192.0.2.1", 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoute53TrafficPolicyInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("www.%s", zoneName)),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "60"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_policy_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "state", "Applied"),
+					resource.TestCheckResourceAttrPair(resourceName, "zone_id", "aws_route53_zone.test", "zone_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "traffic_policy_id", "aws_route53_traffic_policy.test", "id"),
+				),
+			},
+			{
+				Config: testAccAWSRoute53TrafficPolicyInstanceConfig(rName, zoneName, "192.0.2.2", 120),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoute53TrafficPolicyInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "120"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_policy_version", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSRoute53TrafficPolicyInstanceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).r53conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53_traffic_policy_instance" {
+			continue
+		}
+
+		_, err := conn.GetTrafficPolicyInstance(&route53.GetTrafficPolicyInstanceInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicyInstance, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Route53 traffic policy instance %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSRoute53TrafficPolicyInstanceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route53 traffic policy instance ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).r53conn
+
+		_, err := conn.GetTrafficPolicyInstance(&route53.GetTrafficPolicyInstanceInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSRoute53TrafficPolicyInstanceConfig(rName, zoneName, address string, ttl int) string {
+	return testAccAWSRoute53TrafficPolicyConfig(rName, "test", address) + fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = "%s"
+}
+
+resource "aws_route53_traffic_policy_instance" "test" {
+  zone_id                = "${aws_route53_zone.test.zone_id}"
+  name                   = "www.%s"
+  ttl                    = %d
+  traffic_policy_id      = "${aws_route53_traffic_policy.test.id}"
+  traffic_policy_version = "${aws_route53_traffic_policy.test.version}"
+}
+`, zoneName, zoneName, ttl)
+}
@@ -0,0 +1,138 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSRoute53TrafficPolicy_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_route53_traffic_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53TrafficPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53TrafficPolicyConfig(rName, "comment", "192.0.2.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoute53TrafficPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "comment", "comment"),
+					resource.TestCheckResourceAttr(resourceName, "type", "A"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccAWSRoute53TrafficPolicyConfig(rName, "comment updated", "192.0.2.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoute53TrafficPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "comment", "comment updated"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccAWSRoute53TrafficPolicyConfig(rName, "comment updated", "192.0.2.2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoute53TrafficPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+					testAccCheckAWSRoute53TrafficPolicyVersionCount(resourceName, 1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSRoute53TrafficPolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).r53conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53_traffic_policy" {
+			continue
+		}
+
+		versions, err := listRoute53TrafficPolicyVersions(conn, rs.Primary.ID)
+		if err != nil {
+			if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicy, "") {
+				continue
+			}
+			return err
+		}
+
+		if len(versions) > 0 {
+			return fmt.Errorf("Route53 traffic policy %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSRoute53TrafficPolicyExists(n string) resource.TestCheckFunc {
+	return testAccCheckAWSRoute53TrafficPolicyVersionCount(n, 0)
+}
+
+// testAccCheckAWSRoute53TrafficPolicyVersionCount checks that the policy exists
+// and, if count is positive, that it has exactly that many versions.
+func testAccCheckAWSRoute53TrafficPolicyVersionCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route53 traffic policy ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).r53conn
+
+		versions, err := listRoute53TrafficPolicyVersions(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if len(versions) == 0 {
+			return fmt.Errorf("Route53 traffic policy %s not found", rs.Primary.ID)
+		}
+
+		if count > 0 && len(versions) != count {
+			return fmt.Errorf("Expected %d versions of Route53 traffic policy %s, got %d", count, rs.Primary.ID, len(versions))
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSRoute53TrafficPolicyConfig(rName, comment, address string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_traffic_policy" "test" {
+  name    = "%s"
+  comment = "%s"
+
+  document = <<EOF
+{
+  "AWSPolicyFormatVersion": "2015-10-01",
+  "RecordType": "A",
+  "Endpoints": {
+    "endpoint-start": {
+      "Type": "value",
+      "Value": "%s"
+    }
+  },
+  "StartEndpoint": "endpoint-start"
+}
+EOF
+}
+`, rName, comment, address)
+}
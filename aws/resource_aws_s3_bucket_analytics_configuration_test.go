@@ -0,0 +1,306 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSS3BucketAnalyticsConfiguration_basic(t *testing.T) {
+	var ac s3.AnalyticsConfiguration
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_analytics_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketAnalyticsConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketAnalyticsConfiguration(rName, rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &ac),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "bucket", fmt.Sprintf("tf-test-bucket-%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketAnalyticsConfiguration_WithStorageClassAnalysis(t *testing.T) {
+	var ac s3.AnalyticsConfiguration
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_analytics_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketAnalyticsConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketAnalyticsConfigurationWithStorageClassAnalysis(rName, "prefix/", rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &ac),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", "prefix/"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.output_schema_version", "V_1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.destination.0.s3_bucket_destination.0.format", "CSV"),
+					resource.TestCheckResourceAttrPair(resourceName, "storage_class_analysis.0.data_export.0.destination.0.s3_bucket_destination.0.bucket_arn", "aws_s3_bucket.destination", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketAnalyticsConfigurationExists(n string, ac *s3.AnalyticsConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+		output, err := conn.GetBucketAnalyticsConfiguration(&s3.GetBucketAnalyticsConfigurationInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Id:     aws.String(rs.Primary.Attributes["name"]),
+		})
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.AnalyticsConfiguration == nil {
+			return fmt.Errorf("S3 bucket analytics configuration (%s) not found", rs.Primary.ID)
+		}
+
+		*ac = *output.AnalyticsConfiguration
+
+		return nil
+	}
+}
+
+func testAccCheckAWSS3BucketAnalyticsConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_analytics_configuration" {
+			continue
+		}
+
+		bucket, name, err := resourceAwsS3BucketAnalyticsConfigurationParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetBucketAnalyticsConfiguration(&s3.GetBucketAnalyticsConfigurationInput{
+			Bucket: aws.String(bucket),
+			Id:     aws.String(name),
+		})
+		if err != nil {
+			if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "NoSuchConfiguration", "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("S3 bucket analytics configuration (%s) still exists", rs.Primary.ID)
+	}
+	return nil
+}
+
+func testAccAWSS3BucketAnalyticsConfiguration(name string, rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket_analytics_configuration" "test" {
+  bucket = "${aws_s3_bucket.test.bucket}"
+  name   = "%s"
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = "tf-test-bucket-%d"
+}
+`, name, rInt)
+}
+
+func testAccAWSS3BucketAnalyticsConfigurationWithStorageClassAnalysis(name, prefix string, rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket_analytics_configuration" "test" {
+  bucket = "${aws_s3_bucket.test.bucket}"
+  name   = "%s"
+
+  filter {
+    prefix = "%s"
+  }
+
+  storage_class_analysis {
+    data_export {
+      destination {
+        s3_bucket_destination {
+          bucket_arn = "${aws_s3_bucket.destination.arn}"
+        }
+      }
+    }
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = "tf-test-bucket-%d"
+}
+
+resource "aws_s3_bucket" "destination" {
+  bucket = "tf-test-bucket-destination-%d"
+}
+`, name, prefix, rInt, rInt)
+}
+
+func TestExpandS3AnalyticsFilter(t *testing.T) {
+	testCases := map[string]struct {
+		Input    map[string]interface{}
+		Expected *s3.AnalyticsFilter
+	}{
+		"nil input": {
+			Input:    map[string]interface{}{},
+			Expected: nil,
+		},
+		"prefix only": {
+			Input: map[string]interface{}{
+				"prefix": "prefix/",
+			},
+			Expected: &s3.AnalyticsFilter{
+				Prefix: aws.String("prefix/"),
+			},
+		},
+		"single tag": {
+			Input: map[string]interface{}{
+				"tags": map[string]interface{}{
+					"tag1key": "tag1value",
+				},
+			},
+			Expected: &s3.AnalyticsFilter{
+				Tag: &s3.Tag{
+					Key:   aws.String("tag1key"),
+					Value: aws.String("tag1value"),
+				},
+			},
+		},
+		"prefix and tags": {
+			Input: map[string]interface{}{
+				"prefix": "prefix/",
+				"tags": map[string]interface{}{
+					"tag1key": "tag1value",
+					"tag2key": "tag2value",
+				},
+			},
+			Expected: &s3.AnalyticsFilter{
+				And: &s3.AnalyticsAndOperator{
+					Prefix: aws.String("prefix/"),
+					Tags: []*s3.Tag{
+						{
+							Key:   aws.String("tag1key"),
+							Value: aws.String("tag1value"),
+						},
+						{
+							Key:   aws.String("tag2key"),
+							Value: aws.String("tag2value"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for k, tc := range testCases {
+		value := expandS3AnalyticsFilter(tc.Input)
+		if value != nil && value.And != nil {
+			sort.Slice(value.And.Tags, func(i, j int) bool {
+				return *value.And.Tags[i].Key < *value.And.Tags[j].Key
+			})
+		}
+
+		if !reflect.DeepEqual(value, tc.Expected) {
+			t.Errorf("%s: Given:\n%s\n\nExpected:\n%s", k, value, tc.Expected)
+		}
+	}
+}
+
+func TestFlattenS3StorageClassAnalysis(t *testing.T) {
+	testCases := map[string]struct {
+		Input    *s3.StorageClassAnalysis
+		Expected []map[string]interface{}
+	}{
+		"nil input": {
+			Input:    nil,
+			Expected: []map[string]interface{}{},
+		},
+		"empty input": {
+			Input:    &s3.StorageClassAnalysis{},
+			Expected: []map[string]interface{}{},
+		},
+		"full input": {
+			Input: &s3.StorageClassAnalysis{
+				DataExport: &s3.StorageClassAnalysisDataExport{
+					OutputSchemaVersion: aws.String(s3.StorageClassAnalysisSchemaVersionV1),
+					Destination: &s3.AnalyticsExportDestination{
+						S3BucketDestination: &s3.AnalyticsS3BucketDestination{
+							Bucket:          aws.String("arn:aws:s3:::destination-bucket"),
+							BucketAccountId: aws.String("1234567890"),
+							Format:          aws.String(s3.AnalyticsS3ExportFileFormatCsv),
+							Prefix:          aws.String("prefix/"),
+						},
+					},
+				},
+			},
+			Expected: []map[string]interface{}{
+				{
+					"data_export": []interface{}{
+						map[string]interface{}{
+							"output_schema_version": s3.StorageClassAnalysisSchemaVersionV1,
+							"destination": []interface{}{
+								map[string]interface{}{
+									"s3_bucket_destination": []interface{}{
+										map[string]interface{}{
+											"bucket_arn":        "arn:aws:s3:::destination-bucket",
+											"bucket_account_id": "1234567890",
+											"format":            s3.AnalyticsS3ExportFileFormatCsv,
+											"prefix":            "prefix/",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for k, tc := range testCases {
+		value := flattenS3StorageClassAnalysis(tc.Input)
+
+		if !reflect.DeepEqual(value, tc.Expected) {
+			t.Errorf("%s: Given:\n%#v\n\nExpected:\n%#v", k, value, tc.Expected)
+		}
+	}
+}
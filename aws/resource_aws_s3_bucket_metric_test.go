@@ -0,0 +1,344 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestExpandS3MetricsFilter(t *testing.T) {
+	testCases := []struct {
+		Config         map[string]interface{}
+		ExpectedFilter *s3.MetricsFilter
+	}{
+		{
+			Config: map[string]interface{}{
+				"prefix": "prefix/",
+			},
+			ExpectedFilter: &s3.MetricsFilter{
+				Prefix: aws.String("prefix/"),
+			},
+		},
+		{
+			Config: map[string]interface{}{
+				"tags": map[string]interface{}{
+					"tag1key": "tag1value",
+				},
+			},
+			ExpectedFilter: &s3.MetricsFilter{
+				Tag: &s3.Tag{
+					Key:   aws.String("tag1key"),
+					Value: aws.String("tag1value"),
+				},
+			},
+		},
+		{
+			Config: map[string]interface{}{
+				"prefix": "prefix/",
+				"tags": map[string]interface{}{
+					"tag1key": "tag1value",
+				},
+			},
+			ExpectedFilter: &s3.MetricsFilter{
+				And: &s3.MetricsAndOperator{
+					Prefix: aws.String("prefix/"),
+					Tags: []*s3.Tag{
+						{
+							Key:   aws.String("tag1key"),
+							Value: aws.String("tag1value"),
+						},
+					},
+				},
+			},
+		},
+		{
+			Config: map[string]interface{}{
+				"tags": map[string]interface{}{
+					"tag1key": "tag1value",
+					"tag2key": "tag2value",
+				},
+			},
+			ExpectedFilter: &s3.MetricsFilter{
+				And: &s3.MetricsAndOperator{
+					Tags: []*s3.Tag{
+						{
+							Key:   aws.String("tag1key"),
+							Value: aws.String("tag1value"),
+						},
+						{
+							Key:   aws.String("tag2key"),
+							Value: aws.String("tag2value"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		value := expandS3MetricsFilter(tc.Config)
+		if value.And != nil {
+			sort.Slice(value.And.Tags, func(i, j int) bool {
+				return *value.And.Tags[i].Key < *value.And.Tags[j].Key
+			})
+		}
+
+		if !reflect.DeepEqual(value, tc.ExpectedFilter) {
+			t.Fatalf("Case #%d: Given:\n%s\n\nExpected:\n%s", i, value, tc.ExpectedFilter)
+		}
+	}
+}
+
+func TestFlattenS3MetricsFilter(t *testing.T) {
+	testCases := []struct {
+		Filter         *s3.MetricsFilter
+		ExpectedConfig map[string]interface{}
+	}{
+		{
+			Filter: &s3.MetricsFilter{
+				Prefix: aws.String("prefix/"),
+			},
+			ExpectedConfig: map[string]interface{}{
+				"prefix": "prefix/",
+			},
+		},
+		{
+			Filter: &s3.MetricsFilter{
+				Tag: &s3.Tag{
+					Key:   aws.String("tag1key"),
+					Value: aws.String("tag1value"),
+				},
+			},
+			ExpectedConfig: map[string]interface{}{
+				"tags": map[string]string{
+					"tag1key": "tag1value",
+				},
+			},
+		},
+		{
+			Filter: &s3.MetricsFilter{
+				And: &s3.MetricsAndOperator{
+					Prefix: aws.String("prefix/"),
+					Tags: []*s3.Tag{
+						{
+							Key:   aws.String("tag1key"),
+							Value: aws.String("tag1value"),
+						},
+						{
+							Key:   aws.String("tag2key"),
+							Value: aws.String("tag2value"),
+						},
+					},
+				},
+			},
+			ExpectedConfig: map[string]interface{}{
+				"prefix": "prefix/",
+				"tags": map[string]string{
+					"tag1key": "tag1value",
+					"tag2key": "tag2value",
+				},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		value := flattenS3MetricsFilter(tc.Filter)
+
+		if !reflect.DeepEqual(value, tc.ExpectedConfig) {
+			t.Fatalf("Case #%d: Given:\n%#v\n\nExpected:\n%#v", i, value, tc.ExpectedConfig)
+		}
+	}
+}
+
+func TestResourceAwsS3BucketMetricParseID(t *testing.T) {
+	validIds := []string{
+		"bucket:name",
+		"my-bucket:EntireBucket",
+	}
+	for _, v := range validIds {
+		if _, _, err := resourceAwsS3BucketMetricParseID(v); err != nil {
+			t.Fatalf("%q should be a valid ID: %s", v, err)
+		}
+	}
+
+	invalidIds := []string{
+		"",
+		"bucket",
+		"bucket:name:extra",
+	}
+	for _, v := range invalidIds {
+		if _, _, err := resourceAwsS3BucketMetricParseID(v); err == nil {
+			t.Fatalf("%q should be an invalid ID", v)
+		}
+	}
+}
+
+func TestAccAWSS3BucketMetric_basic(t *testing.T) {
+	var conf s3.MetricsConfiguration
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_metric.test"
+
+	bucketName := fmt.Sprintf("tf-acc-%d", rInt)
+	metricName := t.Name()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketMetricDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketMetricsConfigWithoutFilter(bucketName, metricName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketMetricsConfigExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "bucket", bucketName),
+					resource.TestCheckNoResourceAttr(resourceName, "filter"),
+					resource.TestCheckResourceAttr(resourceName, "name", metricName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketMetric_WithFilterPrefixAndTags(t *testing.T) {
+	var conf s3.MetricsConfiguration
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_metric.test"
+
+	bucketName := fmt.Sprintf("tf-acc-%d", rInt)
+	metricName := t.Name()
+	prefix := fmt.Sprintf("prefix-%d/", rInt)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketMetricDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketMetricsConfigWithFilterPrefixAndTags(bucketName, metricName, prefix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketMetricsConfigExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", prefix),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.%", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketMetricDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_metric" {
+			continue
+		}
+
+		bucket, name, err := resourceAwsS3BucketMetricParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetBucketMetricsConfiguration(&s3.GetBucketMetricsConfigurationInput{
+			Bucket: aws.String(bucket),
+			Id:     aws.String(name),
+		})
+		if err != nil {
+			if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "NoSuchConfiguration", "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("S3 bucket metrics configuration (%s) still exists", rs.Primary.ID)
+	}
+	return nil
+}
+
+func testAccCheckAWSS3BucketMetricsConfigExists(n string, res *s3.MetricsConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 bucket metrics configuration ID is set")
+		}
+
+		bucket, name, err := resourceAwsS3BucketMetricParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+		output, err := conn.GetBucketMetricsConfiguration(&s3.GetBucketMetricsConfigurationInput{
+			Bucket: aws.String(bucket),
+			Id:     aws.String(name),
+		})
+		if err != nil {
+			return err
+		}
+
+		*res = *output.MetricsConfiguration
+
+		return nil
+	}
+}
+
+func testAccAWSS3BucketMetricsConfigBucket(name string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "bucket" {
+  acl    = "public-read"
+  bucket = "%s"
+}
+`, name)
+}
+
+func testAccAWSS3BucketMetricsConfigWithoutFilter(bucketName, metricName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_s3_bucket_metric" "test" {
+  bucket = "${aws_s3_bucket.bucket.id}"
+  name   = "%s"
+}
+`, testAccAWSS3BucketMetricsConfigBucket(bucketName), metricName)
+}
+
+func testAccAWSS3BucketMetricsConfigWithFilterPrefixAndTags(bucketName, metricName, prefix string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_s3_bucket_metric" "test" {
+  bucket = "${aws_s3_bucket.bucket.id}"
+  name   = "%s"
+
+  filter {
+    prefix = "%s"
+
+    tags {
+      "tag1" = "value1"
+      "tag2" = "value2"
+    }
+  }
+}
+`, testAccAWSS3BucketMetricsConfigBucket(bucketName), metricName, prefix)
+}
@@ -21,9 +21,9 @@ import (
 
 func resourceAwsS3BucketObject() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsS3BucketObjectPut,
+		Create: resourceAwsS3BucketObjectCreate,
 		Read:   resourceAwsS3BucketObjectRead,
-		Update: resourceAwsS3BucketObjectPut,
+		Update: resourceAwsS3BucketObjectUpdate,
 		Delete: resourceAwsS3BucketObjectDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -129,6 +129,61 @@ func resourceAwsS3BucketObject() *schema.Resource {
 	}
 }
 
+func resourceAwsS3BucketObjectCreate(d *schema.ResourceData, meta interface{}) error {
+	return resourceAwsS3BucketObjectPut(d, meta)
+}
+
+func resourceAwsS3BucketObjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	// Changes to any of these attributes require a new object to be uploaded.
+	uploadAttrs := []string{
+		"cache_control",
+		"content",
+		"content_disposition",
+		"content_encoding",
+		"content_language",
+		"content_type",
+		"etag",
+		"kms_key_id",
+		"server_side_encryption",
+		"source",
+		"storage_class",
+		"website_redirect",
+	}
+	for _, attr := range uploadAttrs {
+		if d.HasChange(attr) {
+			return resourceAwsS3BucketObjectPut(d, meta)
+		}
+	}
+
+	s3conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	if d.HasChange("acl") {
+		_, err := s3conn.PutObjectAcl(&s3.PutObjectAclInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+			ACL:    aws.String(d.Get("acl").(string)),
+		})
+		if err != nil {
+			return fmt.Errorf("Error putting S3 object ACL (bucket: %s, key: %s): %s", bucket, key, err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if meta.(*AWSClient).IsChinaCloud() {
+			return fmt.Errorf("This region does not allow for tags on S3 objects")
+		}
+
+		if err := setTagsS3Object(s3conn, d); err != nil {
+			return fmt.Errorf("Error updating S3 object tags (bucket: %s, key: %s): %s", bucket, key, err)
+		}
+	}
+
+	return resourceAwsS3BucketObjectRead(d, meta)
+}
+
 func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) error {
 	s3conn := meta.(*AWSClient).s3conn
 
@@ -287,6 +342,25 @@ func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) err
 		d.Set("storage_class", resp.StorageClass)
 	}
 
+	aclResp, err := s3conn.GetObjectAcl(
+		&s3.GetObjectAclInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+	if err != nil {
+		return fmt.Errorf("Failed to get object ACL (bucket: %s, key: %s): %s", bucket, key, err)
+	}
+	// Only canned ACLs which can be unambiguously derived from the object's grants
+	// are reconciled. The remaining canned ACLs depend on the bucket owner or on
+	// AWS-internal grantees, so the configured value is left untouched for those.
+	switch d.Get("acl").(string) {
+	case s3.ObjectCannedACLAwsExecRead, s3.ObjectCannedACLBucketOwnerRead, s3.ObjectCannedACLBucketOwnerFullControl:
+	default:
+		if acl := s3ObjectCannedAclFromGrants(aclResp.Owner, aclResp.Grants); acl != "" {
+			d.Set("acl", acl)
+		}
+	}
+
 	if !restricted {
 		tagResp, err := s3conn.GetObjectTagging(
 			&s3.GetObjectTaggingInput{
@@ -346,6 +420,64 @@ func resourceAwsS3BucketObjectDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+// s3ObjectCannedAclFromGrants returns the canned ACL matching the given grants,
+// or an empty string if the grants don't match a canned ACL that can be
+// determined from the object ACL alone.
+func s3ObjectCannedAclFromGrants(owner *s3.Owner, grants []*s3.Grant) string {
+	const (
+		allUsersUri           = "http://acs.amazonaws.com/groups/global/AllUsers"
+		authenticatedUsersUri = "http://acs.amazonaws.com/groups/global/AuthenticatedUsers"
+	)
+
+	ownerFullControl := false
+	groupPermissions := make(map[string][]string)
+	for _, grant := range grants {
+		if grant.Grantee == nil {
+			return ""
+		}
+		permission := aws.StringValue(grant.Permission)
+
+		switch aws.StringValue(grant.Grantee.Type) {
+		case s3.TypeCanonicalUser:
+			if owner == nil || aws.StringValue(grant.Grantee.ID) != aws.StringValue(owner.ID) || permission != s3.PermissionFullControl {
+				return ""
+			}
+			ownerFullControl = true
+		case s3.TypeGroup:
+			uri := aws.StringValue(grant.Grantee.URI)
+			groupPermissions[uri] = append(groupPermissions[uri], permission)
+		default:
+			return ""
+		}
+	}
+
+	if !ownerFullControl {
+		return ""
+	}
+
+	switch len(groupPermissions) {
+	case 0:
+		return s3.ObjectCannedACLPrivate
+	case 1:
+		if permissions, ok := groupPermissions[allUsersUri]; ok {
+			sort.Strings(permissions)
+			switch strings.Join(permissions, ",") {
+			case s3.PermissionRead:
+				return s3.ObjectCannedACLPublicRead
+			case s3.PermissionRead + "," + s3.PermissionWrite:
+				return s3.ObjectCannedACLPublicReadWrite
+			}
+		}
+		if permissions, ok := groupPermissions[authenticatedUsersUri]; ok {
+			if len(permissions) == 1 && permissions[0] == s3.PermissionRead {
+				return s3.ObjectCannedACLAuthenticatedRead
+			}
+		}
+	}
+
+	return ""
+}
+
 func validateS3BucketObjectAclType(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
@@ -536,7 +536,7 @@ func testAccCheckAWSS3BucketObjectSSE(n, expectedSSE string) resource.TestCheckF
 
 func TestAccAWSS3BucketObject_tags(t *testing.T) {
 	rInt := acctest.RandInt()
-	var obj s3.GetObjectOutput
+	var obj1, obj2, obj3 s3.GetObjectOutput
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -547,14 +547,137 @@ func TestAccAWSS3BucketObject_tags(t *testing.T) {
 				PreConfig: func() {},
 				Config:    testAccAWSS3BucketObjectConfig_withTags(rInt),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object", &obj),
+					testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object", &obj1),
 					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "tags.%", "2"),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "tags.Key1", "Value One"),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "tags.Description", "Very interesting"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSS3BucketObjectConfig_withUpdatedTags(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object", &obj2),
+					testAccCheckAWSS3BucketObjectVersionIdEquals(&obj2, &obj1),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "tags.%", "3"),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "tags.Key1", "Value One Changed"),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "tags.Key2", "Value Two"),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "tags.Key3", "Value Three"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSS3BucketObjectConfig_noTags(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object", &obj3),
+					testAccCheckAWSS3BucketObjectVersionIdEquals(&obj3, &obj1),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketObject_aclUpdateWithoutUpload(t *testing.T) {
+	rInt := acctest.RandInt()
+	var obj1, obj2 s3.GetObjectOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSS3BucketObjectConfig_aclVersioned(rInt, "public-read"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object", &obj1),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "acl", "public-read"),
+					testAccCheckAWSS3BucketObjectAcl("aws_s3_bucket_object.object", []string{"FULL_CONTROL", "READ"}),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSS3BucketObjectConfig_aclVersioned(rInt, "private"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object", &obj2),
+					testAccCheckAWSS3BucketObjectVersionIdEquals(&obj2, &obj1),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "acl", "private"),
+					testAccCheckAWSS3BucketObjectAcl("aws_s3_bucket_object.object", []string{"FULL_CONTROL"}),
 				),
 			},
 		},
 	})
 }
 
+func testAccCheckAWSS3BucketObjectVersionIdEquals(first, second *s3.GetObjectOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(first.VersionId) != aws.StringValue(second.VersionId) {
+			return fmt.Errorf("Expected S3 object version ID %q, got %q", aws.StringValue(second.VersionId), aws.StringValue(first.VersionId))
+		}
+
+		return nil
+	}
+}
+
+func TestS3ObjectCannedAclFromGrants(t *testing.T) {
+	owner := &s3.Owner{ID: aws.String("owner")}
+	ownerGrant := &s3.Grant{
+		Grantee:    &s3.Grantee{Type: aws.String(s3.TypeCanonicalUser), ID: aws.String("owner")},
+		Permission: aws.String(s3.PermissionFullControl),
+	}
+	groupGrant := func(uri, permission string) *s3.Grant {
+		return &s3.Grant{
+			Grantee:    &s3.Grantee{Type: aws.String(s3.TypeGroup), URI: aws.String(uri)},
+			Permission: aws.String(permission),
+		}
+	}
+	allUsers := "http://acs.amazonaws.com/groups/global/AllUsers"
+	authenticatedUsers := "http://acs.amazonaws.com/groups/global/AuthenticatedUsers"
+
+	cases := []struct {
+		Grants   []*s3.Grant
+		Expected string
+	}{
+		{
+			Grants:   []*s3.Grant{ownerGrant},
+			Expected: s3.ObjectCannedACLPrivate,
+		},
+		{
+			Grants:   []*s3.Grant{ownerGrant, groupGrant(allUsers, s3.PermissionRead)},
+			Expected: s3.ObjectCannedACLPublicRead,
+		},
+		{
+			Grants:   []*s3.Grant{groupGrant(allUsers, s3.PermissionWrite), ownerGrant, groupGrant(allUsers, s3.PermissionRead)},
+			Expected: s3.ObjectCannedACLPublicReadWrite,
+		},
+		{
+			Grants:   []*s3.Grant{ownerGrant, groupGrant(authenticatedUsers, s3.PermissionRead)},
+			Expected: s3.ObjectCannedACLAuthenticatedRead,
+		},
+		{
+			Grants:   []*s3.Grant{groupGrant(allUsers, s3.PermissionRead)},
+			Expected: "",
+		},
+		{
+			Grants:   []*s3.Grant{ownerGrant, groupGrant(authenticatedUsers, s3.PermissionWrite)},
+			Expected: "",
+		},
+		{
+			Grants: []*s3.Grant{
+				ownerGrant,
+				{
+					Grantee:    &s3.Grantee{Type: aws.String(s3.TypeCanonicalUser), ID: aws.String("other")},
+					Permission: aws.String(s3.PermissionRead),
+				},
+			},
+			Expected: "",
+		},
+	}
+
+	for i, tc := range cases {
+		if actual := s3ObjectCannedAclFromGrants(owner, tc.Grants); actual != tc.Expected {
+			t.Fatalf("Case %d: expected %q, got %q", i, tc.Expected, actual)
+		}
+	}
+}
+
 func testAccAWSS3BucketObjectConfigSource(randInt int, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "object_bucket" {
@@ -697,6 +820,9 @@ func testAccAWSS3BucketObjectConfig_withTags(randInt int) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "object_bucket_2" {
 	bucket = "tf-object-test-bucket-%d"
+	versioning {
+		enabled = true
+	}
 }
 
 resource "aws_s3_bucket_object" "object" {
@@ -710,3 +836,60 @@ resource "aws_s3_bucket_object" "object" {
 }
 `, randInt)
 }
+
+func testAccAWSS3BucketObjectConfig_withUpdatedTags(randInt int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket_2" {
+	bucket = "tf-object-test-bucket-%d"
+	versioning {
+		enabled = true
+	}
+}
+
+resource "aws_s3_bucket_object" "object" {
+	bucket = "${aws_s3_bucket.object_bucket_2.bucket}"
+	key = "test-key"
+	content = "stuff"
+	tags {
+		Key1 = "Value One Changed"
+		Key2 = "Value Two"
+		Key3 = "Value Three"
+	}
+}
+`, randInt)
+}
+
+func testAccAWSS3BucketObjectConfig_noTags(randInt int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket_2" {
+	bucket = "tf-object-test-bucket-%d"
+	versioning {
+		enabled = true
+	}
+}
+
+resource "aws_s3_bucket_object" "object" {
+	bucket = "${aws_s3_bucket.object_bucket_2.bucket}"
+	key = "test-key"
+	content = "stuff"
+}
+`, randInt)
+}
+
+func testAccAWSS3BucketObjectConfig_aclVersioned(randInt int, acl string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket" {
+	bucket = "tf-object-test-bucket-%d"
+	versioning {
+		enabled = true
+	}
+}
+
+resource "aws_s3_bucket_object" "object" {
+	bucket = "${aws_s3_bucket.object_bucket.bucket}"
+	key = "test-key"
+	content = "some_bucket_content"
+	acl = "%s"
+}
+`, randInt, acl)
+}
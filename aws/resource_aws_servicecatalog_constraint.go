@@ -0,0 +1,185 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsServiceCatalogConstraint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogConstraintCreate,
+		Read:   resourceAwsServiceCatalogConstraintRead,
+		Update: resourceAwsServiceCatalogConstraintUpdate,
+		Delete: resourceAwsServiceCatalogConstraintDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"portfolio_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"product_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"LAUNCH",
+					"NOTIFICATION",
+					"TEMPLATE",
+				}, false),
+			},
+			"parameters": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogConstraintCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	input := servicecatalog.CreateConstraintInput{
+		AcceptLanguage:   aws.String("en"),
+		IdempotencyToken: aws.String(fmt.Sprintf("%d", time.Now().UnixNano())),
+		Parameters:       aws.String(d.Get("parameters").(string)),
+		PortfolioId:      aws.String(d.Get("portfolio_id").(string)),
+		ProductId:        aws.String(d.Get("product_id").(string)),
+		Type:             aws.String(d.Get("type").(string)),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Constraint: %#v", input)
+	resp, err := conn.CreateConstraint(&input)
+	if err != nil {
+		return fmt.Errorf("Creating Service Catalog Constraint failed: %s", err.Error())
+	}
+	d.SetId(*resp.ConstraintDetail.ConstraintId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{servicecatalog.StatusCreating},
+		Target:     []string{servicecatalog.StatusAvailable},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 3 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			resp, err := conn.DescribeConstraint(&servicecatalog.DescribeConstraintInput{
+				AcceptLanguage: aws.String("en"),
+				Id:             aws.String(d.Id()),
+			})
+			if err != nil {
+				return nil, "", err
+			}
+			status := aws.StringValue(resp.Status)
+			if status == servicecatalog.StatusFailed {
+				return resp, status, fmt.Errorf("Service Catalog Constraint '%s' failed to create", d.Id())
+			}
+			return resp, status, nil
+		},
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Service Catalog Constraint '%s' to become available: %s", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogConstraintRead(d, meta)
+}
+
+func resourceAwsServiceCatalogConstraintRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	input := servicecatalog.DescribeConstraintInput{
+		AcceptLanguage: aws.String("en"),
+		Id:             aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading Service Catalog Constraint: %#v", input)
+	resp, err := conn.DescribeConstraint(&input)
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Service Catalog Constraint %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Reading Service Catalog Constraint '%s' failed: %s", d.Id(), err.Error())
+	}
+
+	detail := resp.ConstraintDetail
+	d.Set("type", detail.Type)
+	d.Set("description", detail.Description)
+	d.Set("owner", detail.Owner)
+	d.Set("status", resp.Status)
+
+	if resp.ConstraintParameters != nil {
+		params, err := normalizeJsonString(*resp.ConstraintParameters)
+		if err != nil {
+			return fmt.Errorf("Service Catalog Constraint '%s' parameters contain an invalid JSON: %s", d.Id(), err)
+		}
+		d.Set("parameters", params)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceCatalogConstraintUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	input := servicecatalog.UpdateConstraintInput{
+		AcceptLanguage: aws.String("en"),
+		Id:             aws.String(d.Id()),
+		Description:    aws.String(d.Get("description").(string)),
+	}
+
+	log.Printf("[DEBUG] Update Service Catalog Constraint: %#v", input)
+	_, err := conn.UpdateConstraint(&input)
+	if err != nil {
+		return fmt.Errorf("Updating Service Catalog Constraint '%s' failed: %s", d.Id(), err.Error())
+	}
+	return resourceAwsServiceCatalogConstraintRead(d, meta)
+}
+
+func resourceAwsServiceCatalogConstraintDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	input := servicecatalog.DeleteConstraintInput{
+		AcceptLanguage: aws.String("en"),
+		Id:             aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Delete Service Catalog Constraint: %#v", input)
+	_, err := conn.DeleteConstraint(&input)
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Deleting Service Catalog Constraint '%s' failed: %s", d.Id(), err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSServiceCatalogConstraint_launch(t *testing.T) {
+	resourceName := "aws_servicecatalog_constraint.test"
+	name := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogConstraintDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogConstraintConfig_launch(name, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogConstraintExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "type", "LAUNCH"),
+					resource.TestCheckResourceAttr(resourceName, "description", "first"),
+					resource.TestCheckResourceAttr(resourceName, "status", "AVAILABLE"),
+					resource.TestCheckResourceAttrSet(resourceName, "owner"),
+				),
+			},
+			{
+				Config: testAccAWSServiceCatalogConstraintConfig_launch(name, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogConstraintExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "second"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSServiceCatalogConstraintExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog Constraint ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).scconn
+		_, err := conn.DescribeConstraint(&servicecatalog.DescribeConstraintInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		return err
+	}
+}
+
+func testAccCheckAWSServiceCatalogConstraintDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_constraint" {
+			continue
+		}
+
+		_, err := conn.DescribeConstraint(&servicecatalog.DescribeConstraintInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("Service Catalog Constraint %q still exists", rs.Primary.ID)
+		}
+		if !isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSServiceCatalogConstraintConfig_launch(name, description string) string {
+	return testAccAWSServiceCatalogProductAssociationConfig(name) + fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = "tf-acc-test-servicecatalog-%s"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "servicecatalog.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_servicecatalog_constraint" "test" {
+  portfolio_id = "${aws_servicecatalog_product_association.test.portfolio_id}"
+  product_id   = "${aws_servicecatalog_product_association.test.product_id}"
+  type         = "LAUNCH"
+  description  = "%s"
+
+  parameters = <<PARAMETERS
+{
+  "RoleArn": "${aws_iam_role.test.arn}"
+}
+PARAMETERS
+}
+`, name, description)
+}
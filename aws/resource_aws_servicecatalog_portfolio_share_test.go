@@ -0,0 +1,119 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSServiceCatalogPortfolioShare_basic(t *testing.T) {
+	accountID := os.Getenv("SERVICECATALOG_SHARE_ACCOUNT_ID")
+	if accountID == "" {
+		t.Skip("Environment variable SERVICECATALOG_SHARE_ACCOUNT_ID is not set")
+	}
+
+	resourceName := "aws_servicecatalog_portfolio_share.test"
+	name := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogPortfolioShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogPortfolioShareConfig(name, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogPortfolioShareExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_id", accountID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSServiceCatalogPortfolioShareExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		found, err := testAccServiceCatalogPortfolioShareFound(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("Service Catalog Portfolio Share %q not found", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckAWSServiceCatalogPortfolioShareDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_portfolio_share" {
+			continue
+		}
+
+		found, err := testAccServiceCatalogPortfolioShareFound(rs.Primary.ID)
+		if err != nil {
+			if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+		if found {
+			return fmt.Errorf("Service Catalog Portfolio Share %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccServiceCatalogPortfolioShareFound(id string) (bool, error) {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	portfolioID, accountID, err := decodeServiceCatalogPortfolioShareID(id)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := conn.ListPortfolioAccess(&servicecatalog.ListPortfolioAccessInput{
+		PortfolioId: aws.String(portfolioID),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	for _, id := range resp.AccountIds {
+		if aws.StringValue(id) == accountID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func testAccAWSServiceCatalogPortfolioShareConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalog_portfolio" "test" {
+  name          = "%s"
+  provider_name = "platform"
+}
+
+resource "aws_servicecatalog_portfolio_share" "test" {
+  portfolio_id = "${aws_servicecatalog_portfolio.test.id}"
+  account_id   = "%s"
+}
+`, name, accountID)
+}
@@ -0,0 +1,149 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsServiceCatalogPrincipalAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogPrincipalAssociationCreate,
+		Read:   resourceAwsServiceCatalogPrincipalAssociationRead,
+		Delete: resourceAwsServiceCatalogPrincipalAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"portfolio_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"principal_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"principal_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  servicecatalog.PrincipalTypeIam,
+				ValidateFunc: validation.StringInSlice([]string{
+					servicecatalog.PrincipalTypeIam,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogPrincipalAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID := d.Get("portfolio_id").(string)
+	principalARN := d.Get("principal_arn").(string)
+	input := servicecatalog.AssociatePrincipalWithPortfolioInput{
+		AcceptLanguage: aws.String("en"),
+		PortfolioId:    aws.String(portfolioID),
+		PrincipalARN:   aws.String(principalARN),
+		PrincipalType:  aws.String(d.Get("principal_type").(string)),
+	}
+
+	log.Printf("[DEBUG] Associating Service Catalog Principal with Portfolio: %#v", input)
+	_, err := conn.AssociatePrincipalWithPortfolio(&input)
+	if err != nil {
+		return fmt.Errorf("Associating Service Catalog Principal '%s' with Portfolio '%s' failed: %s", principalARN, portfolioID, err.Error())
+	}
+	d.SetId(fmt.Sprintf("%s:%s", portfolioID, principalARN))
+
+	return resourceAwsServiceCatalogPrincipalAssociationRead(d, meta)
+}
+
+func resourceAwsServiceCatalogPrincipalAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID, principalARN, err := decodeServiceCatalogPrincipalAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := servicecatalog.ListPrincipalsForPortfolioInput{
+		AcceptLanguage: aws.String("en"),
+		PortfolioId:    aws.String(portfolioID),
+	}
+
+	log.Printf("[DEBUG] Reading Service Catalog Principal Association: %#v", input)
+	var principal *servicecatalog.Principal
+	err = conn.ListPrincipalsForPortfolioPages(&input, func(page *servicecatalog.ListPrincipalsForPortfolioOutput, lastPage bool) bool {
+		for _, p := range page.Principals {
+			if aws.StringValue(p.PrincipalARN) == principalARN {
+				principal = p
+				return false
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Service Catalog Portfolio %q not found, removing association %q from state", portfolioID, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Reading Service Catalog Principal Association '%s' failed: %s", d.Id(), err.Error())
+	}
+
+	if principal == nil {
+		log.Printf("[WARN] Service Catalog Principal Association %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("portfolio_id", portfolioID)
+	d.Set("principal_arn", principal.PrincipalARN)
+	d.Set("principal_type", principal.PrincipalType)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogPrincipalAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID, principalARN, err := decodeServiceCatalogPrincipalAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := servicecatalog.DisassociatePrincipalFromPortfolioInput{
+		AcceptLanguage: aws.String("en"),
+		PortfolioId:    aws.String(portfolioID),
+		PrincipalARN:   aws.String(principalARN),
+	}
+
+	log.Printf("[DEBUG] Disassociating Service Catalog Principal from Portfolio: %#v", input)
+	_, err = conn.DisassociatePrincipalFromPortfolio(&input)
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Disassociating Service Catalog Principal '%s' from Portfolio '%s' failed: %s", principalARN, portfolioID, err.Error())
+	}
+	return nil
+}
+
+// Principal ARNs contain colons, so only the first one separates the parts
+func decodeServiceCatalogPrincipalAssociationID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Service Catalog Principal Association ID must be of the form <portfolio_id>:<principal_arn>, was provided: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
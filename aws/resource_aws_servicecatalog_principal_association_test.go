@@ -0,0 +1,170 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDecodeServiceCatalogPrincipalAssociationID(t *testing.T) {
+	var testCases = []struct {
+		Input        string
+		PortfolioID  string
+		PrincipalARN string
+		ErrCount     int
+	}{
+		{
+			Input:    "port-abc123",
+			ErrCount: 1,
+		},
+		{
+			Input:    "port-abc123:",
+			ErrCount: 1,
+		},
+		{
+			Input:        "port-abc123:arn:aws:iam::123456789012:role/test",
+			PortfolioID:  "port-abc123",
+			PrincipalARN: "arn:aws:iam::123456789012:role/test",
+			ErrCount:     0,
+		},
+	}
+
+	for _, tc := range testCases {
+		portfolioID, principalARN, err := decodeServiceCatalogPrincipalAssociationID(tc.Input)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.Input, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.Input)
+		}
+		if portfolioID != tc.PortfolioID || principalARN != tc.PrincipalARN {
+			t.Fatalf("expected %q to decode to %q and %q, received %q and %q", tc.Input, tc.PortfolioID, tc.PrincipalARN, portfolioID, principalARN)
+		}
+	}
+}
+
+func TestAccAWSServiceCatalogPrincipalAssociation_basic(t *testing.T) {
+	resourceName := "aws_servicecatalog_principal_association.test"
+	name := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogPrincipalAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogPrincipalAssociationConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogPrincipalAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "principal_type", "IAM"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSServiceCatalogPrincipalAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		found, err := testAccServiceCatalogPrincipalAssociationFound(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("Service Catalog Principal Association %q not found", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckAWSServiceCatalogPrincipalAssociationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_principal_association" {
+			continue
+		}
+
+		found, err := testAccServiceCatalogPrincipalAssociationFound(rs.Primary.ID)
+		if err != nil {
+			if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+		if found {
+			return fmt.Errorf("Service Catalog Principal Association %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccServiceCatalogPrincipalAssociationFound(id string) (bool, error) {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	portfolioID, principalARN, err := decodeServiceCatalogPrincipalAssociationID(id)
+	if err != nil {
+		return false, err
+	}
+
+	found := false
+	input := &servicecatalog.ListPrincipalsForPortfolioInput{
+		PortfolioId: aws.String(portfolioID),
+	}
+	err = conn.ListPrincipalsForPortfolioPages(input, func(page *servicecatalog.ListPrincipalsForPortfolioOutput, lastPage bool) bool {
+		for _, p := range page.Principals {
+			if aws.StringValue(p.PrincipalARN) == principalARN {
+				found = true
+				return false
+			}
+		}
+		return !lastPage
+	})
+	return found, err
+}
+
+func testAccAWSServiceCatalogPrincipalAssociationConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalog_portfolio" "test" {
+  name          = "%s"
+  provider_name = "platform"
+}
+
+resource "aws_iam_role" "test" {
+  name = "tf-acc-test-servicecatalog-%s"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "ec2.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_servicecatalog_principal_association" "test" {
+  portfolio_id  = "${aws_servicecatalog_portfolio.test.id}"
+  principal_arn = "${aws_iam_role.test.arn}"
+}
+`, name, name)
+}
@@ -0,0 +1,323 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsServiceCatalogProduct() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogProductCreate,
+		Read:   resourceAwsServiceCatalogProductRead,
+		Update: resourceAwsServiceCatalogProductUpdate,
+		Delete: resourceAwsServiceCatalogProductDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"distributor": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"support_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"support_email": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"support_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"product_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  servicecatalog.ProductTypeCloudFormationTemplate,
+				ValidateFunc: validation.StringInSlice([]string{
+					servicecatalog.ProductTypeCloudFormationTemplate,
+					servicecatalog.ProductTypeMarketplace,
+				}, false),
+			},
+			"provisioning_artifact_parameters": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"template_url": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  servicecatalog.ProvisioningArtifactTypeCloudFormationTemplate,
+							ValidateFunc: validation.StringInSlice([]string{
+								servicecatalog.ProvisioningArtifactTypeCloudFormationTemplate,
+								servicecatalog.ProvisioningArtifactTypeMarketplaceAmi,
+								servicecatalog.ProvisioningArtifactTypeMarketplaceCar,
+							}, false),
+						},
+					},
+				},
+			},
+			"provisioning_artifact_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsServiceCatalogProductCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	input := servicecatalog.CreateProductInput{
+		AcceptLanguage:   aws.String("en"),
+		IdempotencyToken: aws.String(fmt.Sprintf("%d", time.Now().UnixNano())),
+		Name:             aws.String(d.Get("name").(string)),
+		Owner:            aws.String(d.Get("owner").(string)),
+		ProductType:      aws.String(d.Get("product_type").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("distributor"); ok {
+		input.Distributor = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("support_description"); ok {
+		input.SupportDescription = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("support_email"); ok {
+		input.SupportEmail = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("support_url"); ok {
+		input.SupportUrl = aws.String(v.(string))
+	}
+
+	input.ProvisioningArtifactParameters = expandServiceCatalogProvisioningArtifactParameters(
+		d.Get("provisioning_artifact_parameters").([]interface{})[0].(map[string]interface{}))
+
+	if v, ok := d.GetOk("tags"); ok {
+		tags := []*servicecatalog.Tag{}
+		for k, v := range v.(map[string]interface{}) {
+			tags = append(tags, &servicecatalog.Tag{
+				Key:   aws.String(k),
+				Value: aws.String(v.(string)),
+			})
+		}
+		input.Tags = tags
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Product: %#v", input)
+	resp, err := conn.CreateProduct(&input)
+	if err != nil {
+		return fmt.Errorf("Creating Service Catalog Product failed: %s", err.Error())
+	}
+	d.SetId(*resp.ProductViewDetail.ProductViewSummary.ProductId)
+	if resp.ProvisioningArtifactDetail != nil {
+		d.Set("provisioning_artifact_id", resp.ProvisioningArtifactDetail.Id)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{servicecatalog.StatusCreating},
+		Target:     []string{servicecatalog.StatusAvailable},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 3 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			resp, err := conn.DescribeProductAsAdmin(&servicecatalog.DescribeProductAsAdminInput{
+				AcceptLanguage: aws.String("en"),
+				Id:             aws.String(d.Id()),
+			})
+			if err != nil {
+				return nil, "", err
+			}
+			status := aws.StringValue(resp.ProductViewDetail.Status)
+			if status == servicecatalog.StatusFailed {
+				return resp, status, fmt.Errorf("Service Catalog Product '%s' failed to create", d.Id())
+			}
+			return resp, status, nil
+		},
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Service Catalog Product '%s' to become available: %s", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogProductRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProductRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	input := servicecatalog.DescribeProductAsAdminInput{
+		AcceptLanguage: aws.String("en"),
+		Id:             aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading Service Catalog Product: %#v", input)
+	resp, err := conn.DescribeProductAsAdmin(&input)
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Service Catalog Product %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Reading Service Catalog Product '%s' failed: %s", d.Id(), err.Error())
+	}
+
+	detail := resp.ProductViewDetail
+	if detail.CreatedTime != nil {
+		d.Set("created_time", detail.CreatedTime.Format(time.RFC3339))
+	}
+	d.Set("arn", detail.ProductARN)
+	d.Set("status", detail.Status)
+
+	summary := detail.ProductViewSummary
+	d.Set("name", summary.Name)
+	d.Set("owner", summary.Owner)
+	d.Set("description", summary.ShortDescription)
+	d.Set("distributor", summary.Distributor)
+	d.Set("support_description", summary.SupportDescription)
+	d.Set("support_email", summary.SupportEmail)
+	d.Set("support_url", summary.SupportUrl)
+	d.Set("product_type", summary.Type)
+
+	tags := map[string]string{}
+	for _, tag := range resp.Tags {
+		tags[*tag.Key] = *tag.Value
+	}
+	d.Set("tags", tags)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogProductUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	input := servicecatalog.UpdateProductInput{
+		AcceptLanguage: aws.String("en"),
+		Id:             aws.String(d.Id()),
+	}
+
+	if d.HasChange("name") {
+		input.Name = aws.String(d.Get("name").(string))
+	}
+	if d.HasChange("owner") {
+		input.Owner = aws.String(d.Get("owner").(string))
+	}
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+	if d.HasChange("distributor") {
+		input.Distributor = aws.String(d.Get("distributor").(string))
+	}
+	if d.HasChange("support_description") {
+		input.SupportDescription = aws.String(d.Get("support_description").(string))
+	}
+	if d.HasChange("support_email") {
+		input.SupportEmail = aws.String(d.Get("support_email").(string))
+	}
+	if d.HasChange("support_url") {
+		input.SupportUrl = aws.String(d.Get("support_url").(string))
+	}
+
+	if d.HasChange("tags") {
+		currentTags, requiredTags := d.GetChange("tags")
+		tagsToAdd, tagsToRemove := tagUpdates(requiredTags.(map[string]interface{}), currentTags.(map[string]interface{}))
+		input.AddTags = tagsToAdd
+		input.RemoveTags = tagsToRemove
+	}
+
+	log.Printf("[DEBUG] Update Service Catalog Product: %#v", input)
+	_, err := conn.UpdateProduct(&input)
+	if err != nil {
+		return fmt.Errorf("Updating Service Catalog Product '%s' failed: %s", d.Id(), err.Error())
+	}
+	return resourceAwsServiceCatalogProductRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProductDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	input := servicecatalog.DeleteProductInput{
+		AcceptLanguage: aws.String("en"),
+		Id:             aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Delete Service Catalog Product: %#v", input)
+	_, err := conn.DeleteProduct(&input)
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Deleting Service Catalog Product '%s' failed: %s", d.Id(), err.Error())
+	}
+	return nil
+}
+
+func expandServiceCatalogProvisioningArtifactParameters(m map[string]interface{}) *servicecatalog.ProvisioningArtifactProperties {
+	params := &servicecatalog.ProvisioningArtifactProperties{
+		Info: map[string]*string{
+			"LoadTemplateFromURL": aws.String(m["template_url"].(string)),
+		},
+		Type: aws.String(m["type"].(string)),
+	}
+	if v, ok := m["name"].(string); ok && v != "" {
+		params.Name = aws.String(v)
+	}
+	if v, ok := m["description"].(string); ok && v != "" {
+		params.Description = aws.String(v)
+	}
+	return params
+}
@@ -0,0 +1,143 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsServiceCatalogProductAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogProductAssociationCreate,
+		Read:   resourceAwsServiceCatalogProductAssociationRead,
+		Delete: resourceAwsServiceCatalogProductAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"portfolio_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"product_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_portfolio_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogProductAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID := d.Get("portfolio_id").(string)
+	productID := d.Get("product_id").(string)
+	input := servicecatalog.AssociateProductWithPortfolioInput{
+		AcceptLanguage: aws.String("en"),
+		PortfolioId:    aws.String(portfolioID),
+		ProductId:      aws.String(productID),
+	}
+	if v, ok := d.GetOk("source_portfolio_id"); ok {
+		input.SourcePortfolioId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Associating Service Catalog Product with Portfolio: %#v", input)
+	_, err := conn.AssociateProductWithPortfolio(&input)
+	if err != nil {
+		return fmt.Errorf("Associating Service Catalog Product '%s' with Portfolio '%s' failed: %s", productID, portfolioID, err.Error())
+	}
+	d.SetId(fmt.Sprintf("%s:%s", portfolioID, productID))
+
+	return resourceAwsServiceCatalogProductAssociationRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProductAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID, productID, err := decodeServiceCatalogProductAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := servicecatalog.ListPortfoliosForProductInput{
+		AcceptLanguage: aws.String("en"),
+		ProductId:      aws.String(productID),
+	}
+
+	log.Printf("[DEBUG] Reading Service Catalog Product Association: %#v", input)
+	found := false
+	err = conn.ListPortfoliosForProductPages(&input, func(page *servicecatalog.ListPortfoliosForProductOutput, lastPage bool) bool {
+		for _, portfolio := range page.PortfolioDetails {
+			if aws.StringValue(portfolio.Id) == portfolioID {
+				found = true
+				return false
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Service Catalog Product %q not found, removing association %q from state", productID, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Reading Service Catalog Product Association '%s' failed: %s", d.Id(), err.Error())
+	}
+
+	if !found {
+		log.Printf("[WARN] Service Catalog Product Association %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("portfolio_id", portfolioID)
+	d.Set("product_id", productID)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogProductAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID, productID, err := decodeServiceCatalogProductAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := servicecatalog.DisassociateProductFromPortfolioInput{
+		AcceptLanguage: aws.String("en"),
+		PortfolioId:    aws.String(portfolioID),
+		ProductId:      aws.String(productID),
+	}
+
+	log.Printf("[DEBUG] Disassociating Service Catalog Product from Portfolio: %#v", input)
+	_, err = conn.DisassociateProductFromPortfolio(&input)
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Disassociating Service Catalog Product '%s' from Portfolio '%s' failed: %s", productID, portfolioID, err.Error())
+	}
+	return nil
+}
+
+func decodeServiceCatalogProductAssociationID(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Service Catalog Product Association ID must be of the form <portfolio_id>:<product_id>, was provided: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
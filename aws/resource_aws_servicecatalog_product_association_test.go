@@ -0,0 +1,113 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSServiceCatalogProductAssociation_basic(t *testing.T) {
+	resourceName := "aws_servicecatalog_product_association.test"
+	name := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogProductAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProductAssociationConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProductAssociationExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSServiceCatalogProductAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		found, err := testAccServiceCatalogProductAssociationFound(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("Service Catalog Product Association %q not found", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckAWSServiceCatalogProductAssociationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_product_association" {
+			continue
+		}
+
+		found, err := testAccServiceCatalogProductAssociationFound(rs.Primary.ID)
+		if err != nil {
+			if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+		if found {
+			return fmt.Errorf("Service Catalog Product Association %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccServiceCatalogProductAssociationFound(id string) (bool, error) {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	portfolioID, productID, err := decodeServiceCatalogProductAssociationID(id)
+	if err != nil {
+		return false, err
+	}
+
+	found := false
+	input := &servicecatalog.ListPortfoliosForProductInput{
+		ProductId: aws.String(productID),
+	}
+	err = conn.ListPortfoliosForProductPages(input, func(page *servicecatalog.ListPortfoliosForProductOutput, lastPage bool) bool {
+		for _, portfolio := range page.PortfolioDetails {
+			if aws.StringValue(portfolio.Id) == portfolioID {
+				found = true
+				return false
+			}
+		}
+		return !lastPage
+	})
+	return found, err
+}
+
+func testAccAWSServiceCatalogProductAssociationConfig(name string) string {
+	return testAccAWSServiceCatalogProductConfig(name, "Value One") + fmt.Sprintf(`
+resource "aws_servicecatalog_portfolio" "test" {
+  name          = "%s"
+  provider_name = "platform"
+}
+
+resource "aws_servicecatalog_product_association" "test" {
+  portfolio_id = "${aws_servicecatalog_portfolio.test.id}"
+  product_id   = "${aws_servicecatalog_product.test.id}"
+}
+`, name)
+}
@@ -0,0 +1,146 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSServiceCatalogProduct_basic(t *testing.T) {
+	resourceName := "aws_servicecatalog_product.test"
+	name := acctest.RandString(5)
+	var dpo servicecatalog.DescribeProductAsAdminOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogProductDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProductConfig(name, "Value One"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProductExists(resourceName, &dpo),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "provisioning_artifact_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "owner", "platform"),
+					resource.TestCheckResourceAttr(resourceName, "product_type", "CLOUD_FORMATION_TEMPLATE"),
+					resource.TestCheckResourceAttr(resourceName, "status", "AVAILABLE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "Value One"),
+				),
+			},
+			{
+				Config: testAccAWSServiceCatalogProductConfig(name, "Value Two"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProductExists(resourceName, &dpo),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "Value Two"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"provisioning_artifact_parameters", "provisioning_artifact_id"},
+			},
+		},
+	})
+}
+
+func testAccCheckAWSServiceCatalogProductExists(n string, dpo *servicecatalog.DescribeProductAsAdminOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog Product ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).scconn
+		resp, err := conn.DescribeProductAsAdmin(&servicecatalog.DescribeProductAsAdminInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*dpo = *resp
+		return nil
+	}
+}
+
+func testAccCheckAWSServiceCatalogProductDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_product" {
+			continue
+		}
+
+		_, err := conn.DescribeProductAsAdmin(&servicecatalog.DescribeProductAsAdminInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("Service Catalog Product %q still exists", rs.Primary.ID)
+		}
+		if !isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSServiceCatalogProductConfigTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = "tf-acc-test-servicecatalog-%s"
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket  = "${aws_s3_bucket.test.id}"
+  key     = "template.json"
+  content = <<TEMPLATE
+{
+  "Resources": {
+    "MyVPC": {
+      "Type": "AWS::EC2::VPC",
+      "Properties": {
+        "CidrBlock": "10.1.0.0/16"
+      }
+    }
+  }
+}
+TEMPLATE
+}
+`, name)
+}
+
+func testAccAWSServiceCatalogProductConfig(name, tagValue string) string {
+	return testAccAWSServiceCatalogProductConfigTemplate(name) + fmt.Sprintf(`
+resource "aws_servicecatalog_product" "test" {
+  name        = "%s"
+  owner       = "platform"
+  description = "test product"
+
+  provisioning_artifact_parameters {
+    name         = "v1"
+    template_url = "https://s3.amazonaws.com/${aws_s3_bucket.test.id}/${aws_s3_bucket_object.test.key}"
+  }
+
+  tags {
+    Key1 = "%s"
+  }
+}
+`, name, tagValue)
+}
@@ -0,0 +1,196 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsServiceCatalogProvisioningArtifact() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogProvisioningArtifactCreate,
+		Read:   resourceAwsServiceCatalogProvisioningArtifactRead,
+		Update: resourceAwsServiceCatalogProvisioningArtifactUpdate,
+		Delete: resourceAwsServiceCatalogProvisioningArtifactDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"product_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"template_url": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  servicecatalog.ProvisioningArtifactTypeCloudFormationTemplate,
+				ValidateFunc: validation.StringInSlice([]string{
+					servicecatalog.ProvisioningArtifactTypeCloudFormationTemplate,
+					servicecatalog.ProvisioningArtifactTypeMarketplaceAmi,
+					servicecatalog.ProvisioningArtifactTypeMarketplaceCar,
+				}, false),
+			},
+			"active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogProvisioningArtifactCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	productID := d.Get("product_id").(string)
+	input := servicecatalog.CreateProvisioningArtifactInput{
+		AcceptLanguage:   aws.String("en"),
+		IdempotencyToken: aws.String(fmt.Sprintf("%d", time.Now().UnixNano())),
+		ProductId:        aws.String(productID),
+		Parameters: expandServiceCatalogProvisioningArtifactParameters(map[string]interface{}{
+			"name":         d.Get("name"),
+			"description":  d.Get("description"),
+			"template_url": d.Get("template_url"),
+			"type":         d.Get("type"),
+		}),
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Provisioning Artifact: %#v", input)
+	resp, err := conn.CreateProvisioningArtifact(&input)
+	if err != nil {
+		return fmt.Errorf("Creating Service Catalog Provisioning Artifact failed: %s", err.Error())
+	}
+	d.SetId(fmt.Sprintf("%s:%s", productID, *resp.ProvisioningArtifactDetail.Id))
+
+	if !d.Get("active").(bool) {
+		return resourceAwsServiceCatalogProvisioningArtifactUpdate(d, meta)
+	}
+
+	return resourceAwsServiceCatalogProvisioningArtifactRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProvisioningArtifactRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	productID, artifactID, err := decodeServiceCatalogProvisioningArtifactID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := servicecatalog.DescribeProvisioningArtifactInput{
+		AcceptLanguage:         aws.String("en"),
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(artifactID),
+	}
+
+	log.Printf("[DEBUG] Reading Service Catalog Provisioning Artifact: %#v", input)
+	resp, err := conn.DescribeProvisioningArtifact(&input)
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Service Catalog Provisioning Artifact %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Reading Service Catalog Provisioning Artifact '%s' failed: %s", d.Id(), err.Error())
+	}
+
+	detail := resp.ProvisioningArtifactDetail
+	d.Set("product_id", productID)
+	d.Set("name", detail.Name)
+	d.Set("description", detail.Description)
+	d.Set("type", detail.Type)
+	d.Set("active", detail.Active)
+	if detail.CreatedTime != nil {
+		d.Set("created_time", detail.CreatedTime.Format(time.RFC3339))
+	}
+	if v, ok := resp.Info["TemplateUrl"]; ok {
+		d.Set("template_url", v)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceCatalogProvisioningArtifactUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	productID, artifactID, err := decodeServiceCatalogProvisioningArtifactID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := servicecatalog.UpdateProvisioningArtifactInput{
+		AcceptLanguage:         aws.String("en"),
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(artifactID),
+		Active:                 aws.Bool(d.Get("active").(bool)),
+		Name:                   aws.String(d.Get("name").(string)),
+		Description:            aws.String(d.Get("description").(string)),
+	}
+
+	log.Printf("[DEBUG] Update Service Catalog Provisioning Artifact: %#v", input)
+	_, err = conn.UpdateProvisioningArtifact(&input)
+	if err != nil {
+		return fmt.Errorf("Updating Service Catalog Provisioning Artifact '%s' failed: %s", d.Id(), err.Error())
+	}
+	return resourceAwsServiceCatalogProvisioningArtifactRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProvisioningArtifactDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	productID, artifactID, err := decodeServiceCatalogProvisioningArtifactID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := servicecatalog.DeleteProvisioningArtifactInput{
+		AcceptLanguage:         aws.String("en"),
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(artifactID),
+	}
+
+	log.Printf("[DEBUG] Delete Service Catalog Provisioning Artifact: %#v", input)
+	_, err = conn.DeleteProvisioningArtifact(&input)
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Deleting Service Catalog Provisioning Artifact '%s' failed: %s", d.Id(), err.Error())
+	}
+	return nil
+}
+
+func decodeServiceCatalogProvisioningArtifactID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Service Catalog Provisioning Artifact ID must be of the form <product_id>:<provisioning_artifact_id>, was provided: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,145 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDecodeServiceCatalogProvisioningArtifactID(t *testing.T) {
+	var testCases = []struct {
+		Input      string
+		ProductID  string
+		ArtifactID string
+		ErrCount   int
+	}{
+		{
+			Input:    "prod-abc123",
+			ErrCount: 1,
+		},
+		{
+			Input:    ":pa-abc123",
+			ErrCount: 1,
+		},
+		{
+			Input:      "prod-abc123:pa-abc123",
+			ProductID:  "prod-abc123",
+			ArtifactID: "pa-abc123",
+			ErrCount:   0,
+		},
+	}
+
+	for _, tc := range testCases {
+		productID, artifactID, err := decodeServiceCatalogProvisioningArtifactID(tc.Input)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.Input, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.Input)
+		}
+		if productID != tc.ProductID || artifactID != tc.ArtifactID {
+			t.Fatalf("expected %q to decode to %q and %q, received %q and %q", tc.Input, tc.ProductID, tc.ArtifactID, productID, artifactID)
+		}
+	}
+}
+
+func TestAccAWSServiceCatalogProvisioningArtifact_basic(t *testing.T) {
+	resourceName := "aws_servicecatalog_provisioning_artifact.test"
+	name := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogProvisioningArtifactDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProvisioningArtifactConfig(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProvisioningArtifactExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "name", "v2"),
+					resource.TestCheckResourceAttr(resourceName, "active", "true"),
+				),
+			},
+			{
+				Config: testAccAWSServiceCatalogProvisioningArtifactConfig(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProvisioningArtifactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "active", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_url"},
+			},
+		},
+	})
+}
+
+func testAccCheckAWSServiceCatalogProvisioningArtifactExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		productID, artifactID, err := decodeServiceCatalogProvisioningArtifactID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).scconn
+		_, err = conn.DescribeProvisioningArtifact(&servicecatalog.DescribeProvisioningArtifactInput{
+			ProductId:              aws.String(productID),
+			ProvisioningArtifactId: aws.String(artifactID),
+		})
+		return err
+	}
+}
+
+func testAccCheckAWSServiceCatalogProvisioningArtifactDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_provisioning_artifact" {
+			continue
+		}
+
+		productID, artifactID, err := decodeServiceCatalogProvisioningArtifactID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.DescribeProvisioningArtifact(&servicecatalog.DescribeProvisioningArtifactInput{
+			ProductId:              aws.String(productID),
+			ProvisioningArtifactId: aws.String(artifactID),
+		})
+		if err == nil {
+			return fmt.Errorf("Service Catalog Provisioning Artifact %q still exists", rs.Primary.ID)
+		}
+		if !isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSServiceCatalogProvisioningArtifactConfig(name string, active bool) string {
+	return testAccAWSServiceCatalogProductConfig(name, "Value One") + fmt.Sprintf(`
+resource "aws_servicecatalog_provisioning_artifact" "test" {
+  product_id   = "${aws_servicecatalog_product.test.id}"
+  name         = "v2"
+  description  = "second version"
+  template_url = "https://s3.amazonaws.com/${aws_s3_bucket.test.id}/${aws_s3_bucket_object.test.key}"
+  active       = %t
+}
+`, active)
+}
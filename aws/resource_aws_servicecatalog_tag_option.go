@@ -0,0 +1,128 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsServiceCatalogTagOption() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogTagOptionCreate,
+		Read:   resourceAwsServiceCatalogTagOptionRead,
+		Update: resourceAwsServiceCatalogTagOptionUpdate,
+		Delete: resourceAwsServiceCatalogTagOptionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"value": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogTagOptionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	input := servicecatalog.CreateTagOptionInput{
+		Key:   aws.String(d.Get("key").(string)),
+		Value: aws.String(d.Get("value").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Tag Option: %#v", input)
+	resp, err := conn.CreateTagOption(&input)
+	if err != nil {
+		return fmt.Errorf("Creating Service Catalog Tag Option failed: %s", err.Error())
+	}
+	d.SetId(*resp.TagOptionDetail.Id)
+
+	if !d.Get("active").(bool) {
+		return resourceAwsServiceCatalogTagOptionUpdate(d, meta)
+	}
+
+	return resourceAwsServiceCatalogTagOptionRead(d, meta)
+}
+
+func resourceAwsServiceCatalogTagOptionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	input := servicecatalog.DescribeTagOptionInput{
+		Id: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading Service Catalog Tag Option: %#v", input)
+	resp, err := conn.DescribeTagOption(&input)
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Service Catalog Tag Option %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Reading Service Catalog Tag Option '%s' failed: %s", d.Id(), err.Error())
+	}
+
+	detail := resp.TagOptionDetail
+	d.Set("key", detail.Key)
+	d.Set("value", detail.Value)
+	d.Set("active", detail.Active)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogTagOptionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	input := servicecatalog.UpdateTagOptionInput{
+		Id:     aws.String(d.Id()),
+		Active: aws.Bool(d.Get("active").(bool)),
+	}
+	if d.HasChange("value") {
+		input.Value = aws.String(d.Get("value").(string))
+	}
+
+	log.Printf("[DEBUG] Update Service Catalog Tag Option: %#v", input)
+	_, err := conn.UpdateTagOption(&input)
+	if err != nil {
+		return fmt.Errorf("Updating Service Catalog Tag Option '%s' failed: %s", d.Id(), err.Error())
+	}
+	return resourceAwsServiceCatalogTagOptionRead(d, meta)
+}
+
+func resourceAwsServiceCatalogTagOptionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	// The Service Catalog API has no way to delete a tag option, so the best
+	// we can do is deactivate it so it can no longer be used.
+	input := servicecatalog.UpdateTagOptionInput{
+		Id:     aws.String(d.Id()),
+		Active: aws.Bool(false),
+	}
+
+	log.Printf("[DEBUG] Deactivating Service Catalog Tag Option: %#v", input)
+	_, err := conn.UpdateTagOption(&input)
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Deactivating Service Catalog Tag Option '%s' failed: %s", d.Id(), err.Error())
+	}
+	return nil
+}
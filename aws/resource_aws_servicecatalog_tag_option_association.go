@@ -0,0 +1,132 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsServiceCatalogTagOptionAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogTagOptionAssociationCreate,
+		Read:   resourceAwsServiceCatalogTagOptionAssociationRead,
+		Delete: resourceAwsServiceCatalogTagOptionAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"tag_option_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogTagOptionAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	tagOptionID := d.Get("tag_option_id").(string)
+	resourceID := d.Get("resource_id").(string)
+	input := servicecatalog.AssociateTagOptionWithResourceInput{
+		ResourceId:  aws.String(resourceID),
+		TagOptionId: aws.String(tagOptionID),
+	}
+
+	log.Printf("[DEBUG] Associating Service Catalog Tag Option with Resource: %#v", input)
+	_, err := conn.AssociateTagOptionWithResource(&input)
+	if err != nil {
+		return fmt.Errorf("Associating Service Catalog Tag Option '%s' with Resource '%s' failed: %s", tagOptionID, resourceID, err.Error())
+	}
+	d.SetId(fmt.Sprintf("%s:%s", tagOptionID, resourceID))
+
+	return resourceAwsServiceCatalogTagOptionAssociationRead(d, meta)
+}
+
+func resourceAwsServiceCatalogTagOptionAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	tagOptionID, resourceID, err := decodeServiceCatalogTagOptionAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := servicecatalog.ListResourcesForTagOptionInput{
+		TagOptionId: aws.String(tagOptionID),
+	}
+
+	log.Printf("[DEBUG] Reading Service Catalog Tag Option Association: %#v", input)
+	found := false
+	err = conn.ListResourcesForTagOptionPages(&input, func(page *servicecatalog.ListResourcesForTagOptionOutput, lastPage bool) bool {
+		for _, r := range page.ResourceDetails {
+			if aws.StringValue(r.Id) == resourceID {
+				found = true
+				return false
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Service Catalog Tag Option %q not found, removing association %q from state", tagOptionID, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Reading Service Catalog Tag Option Association '%s' failed: %s", d.Id(), err.Error())
+	}
+
+	if !found {
+		log.Printf("[WARN] Service Catalog Tag Option Association %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("tag_option_id", tagOptionID)
+	d.Set("resource_id", resourceID)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogTagOptionAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	tagOptionID, resourceID, err := decodeServiceCatalogTagOptionAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := servicecatalog.DisassociateTagOptionFromResourceInput{
+		ResourceId:  aws.String(resourceID),
+		TagOptionId: aws.String(tagOptionID),
+	}
+
+	log.Printf("[DEBUG] Disassociating Service Catalog Tag Option from Resource: %#v", input)
+	_, err = conn.DisassociateTagOptionFromResource(&input)
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Disassociating Service Catalog Tag Option '%s' from Resource '%s' failed: %s", tagOptionID, resourceID, err.Error())
+	}
+	return nil
+}
+
+func decodeServiceCatalogTagOptionAssociationID(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Service Catalog Tag Option Association ID must be of the form <tag_option_id>:<resource_id>, was provided: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
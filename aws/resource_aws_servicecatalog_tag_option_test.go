@@ -0,0 +1,145 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSServiceCatalogTagOption_basic(t *testing.T) {
+	resourceName := "aws_servicecatalog_tag_option.test"
+	key := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogTagOptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogTagOptionConfig(key, "one", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogTagOptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key", key),
+					resource.TestCheckResourceAttr(resourceName, "value", "one"),
+					resource.TestCheckResourceAttr(resourceName, "active", "true"),
+				),
+			},
+			{
+				Config: testAccAWSServiceCatalogTagOptionConfig(key, "two", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogTagOptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", "two"),
+					resource.TestCheckResourceAttr(resourceName, "active", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSServiceCatalogTagOptionAssociation_basic(t *testing.T) {
+	resourceName := "aws_servicecatalog_tag_option_association.test"
+	name := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogTagOptionAssociationConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "tag_option_id", "aws_servicecatalog_tag_option.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_id", "aws_servicecatalog_portfolio.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSServiceCatalogTagOptionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog Tag Option ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).scconn
+		_, err := conn.DescribeTagOption(&servicecatalog.DescribeTagOptionInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		return err
+	}
+}
+
+// Tag options cannot be deleted, only deactivated
+func testAccCheckAWSServiceCatalogTagOptionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_tag_option" {
+			continue
+		}
+
+		resp, err := conn.DescribeTagOption(&servicecatalog.DescribeTagOptionInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+		if aws.BoolValue(resp.TagOptionDetail.Active) {
+			return fmt.Errorf("Service Catalog Tag Option %q is still active", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSServiceCatalogTagOptionConfig(key, value string, active bool) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalog_tag_option" "test" {
+  key    = "%s"
+  value  = "%s"
+  active = %t
+}
+`, key, value, active)
+}
+
+func testAccAWSServiceCatalogTagOptionAssociationConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalog_portfolio" "test" {
+  name          = "%s"
+  provider_name = "platform"
+}
+
+resource "aws_servicecatalog_tag_option" "test" {
+  key   = "tf-acc-test-%s"
+  value = "test"
+}
+
+resource "aws_servicecatalog_tag_option_association" "test" {
+  tag_option_id = "${aws_servicecatalog_tag_option.test.id}"
+  resource_id   = "${aws_servicecatalog_portfolio.test.id}"
+}
+`, name, name)
+}
@@ -0,0 +1,141 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsSesCustomVerificationEmailTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSesCustomVerificationEmailTemplateCreate,
+		Read:   resourceAwsSesCustomVerificationEmailTemplateRead,
+		Update: resourceAwsSesCustomVerificationEmailTemplateUpdate,
+		Delete: resourceAwsSesCustomVerificationEmailTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSesTemplateName,
+			},
+			"from_email_address": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"subject": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"success_redirection_url": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"failure_redirection_url": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSesCustomVerificationEmailTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesConn
+
+	templateName := d.Get("name").(string)
+
+	input := ses.CreateCustomVerificationEmailTemplateInput{
+		FailureRedirectionURL: aws.String(d.Get("failure_redirection_url").(string)),
+		FromEmailAddress:      aws.String(d.Get("from_email_address").(string)),
+		SuccessRedirectionURL: aws.String(d.Get("success_redirection_url").(string)),
+		TemplateContent:       aws.String(d.Get("content").(string)),
+		TemplateName:          aws.String(templateName),
+		TemplateSubject:       aws.String(d.Get("subject").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating SES custom verification email template: %#v", input)
+	_, err := conn.CreateCustomVerificationEmailTemplate(&input)
+	if err != nil {
+		return fmt.Errorf("Creating SES custom verification email template failed: %s", err.Error())
+	}
+	d.SetId(templateName)
+
+	return resourceAwsSesCustomVerificationEmailTemplateRead(d, meta)
+}
+
+func resourceAwsSesCustomVerificationEmailTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesConn
+
+	input := ses.GetCustomVerificationEmailTemplateInput{
+		TemplateName: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading SES custom verification email template: %#v", input)
+	resp, err := conn.GetCustomVerificationEmailTemplate(&input)
+	if err != nil {
+		if isAWSErr(err, ses.ErrCodeCustomVerificationEmailTemplateDoesNotExistException, "") {
+			log.Printf("[WARN] SES custom verification email template %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Reading SES custom verification email template '%s' failed: %s", d.Id(), err.Error())
+	}
+
+	d.Set("name", resp.TemplateName)
+	d.Set("from_email_address", resp.FromEmailAddress)
+	d.Set("subject", resp.TemplateSubject)
+	d.Set("content", resp.TemplateContent)
+	d.Set("success_redirection_url", resp.SuccessRedirectionURL)
+	d.Set("failure_redirection_url", resp.FailureRedirectionURL)
+
+	return nil
+}
+
+func resourceAwsSesCustomVerificationEmailTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesConn
+
+	input := ses.UpdateCustomVerificationEmailTemplateInput{
+		FailureRedirectionURL: aws.String(d.Get("failure_redirection_url").(string)),
+		FromEmailAddress:      aws.String(d.Get("from_email_address").(string)),
+		SuccessRedirectionURL: aws.String(d.Get("success_redirection_url").(string)),
+		TemplateContent:       aws.String(d.Get("content").(string)),
+		TemplateName:          aws.String(d.Id()),
+		TemplateSubject:       aws.String(d.Get("subject").(string)),
+	}
+
+	log.Printf("[DEBUG] Update SES custom verification email template: %#v", input)
+	_, err := conn.UpdateCustomVerificationEmailTemplate(&input)
+	if err != nil {
+		return fmt.Errorf("Updating SES custom verification email template '%s' failed: %s", d.Id(), err.Error())
+	}
+
+	return resourceAwsSesCustomVerificationEmailTemplateRead(d, meta)
+}
+
+func resourceAwsSesCustomVerificationEmailTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesConn
+
+	input := ses.DeleteCustomVerificationEmailTemplateInput{
+		TemplateName: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Delete SES custom verification email template: %#v", input)
+	_, err := conn.DeleteCustomVerificationEmailTemplate(&input)
+	if err != nil {
+		return fmt.Errorf("Deleting SES custom verification email template '%s' failed: %s", d.Id(), err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSesCustomVerificationEmailTemplate_basic(t *testing.T) {
+	name := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSesCustomVerificationEmailTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSesCustomVerificationEmailTemplateConfig(name, "subject"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSesCustomVerificationEmailTemplateExists("aws_ses_custom_verification_email_template.test"),
+					resource.TestCheckResourceAttr("aws_ses_custom_verification_email_template.test", "name", name),
+					resource.TestCheckResourceAttr("aws_ses_custom_verification_email_template.test", "subject", "subject"),
+				),
+			},
+			{
+				Config: testAccAwsSesCustomVerificationEmailTemplateConfig(name, "updated subject"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSesCustomVerificationEmailTemplateExists("aws_ses_custom_verification_email_template.test"),
+					resource.TestCheckResourceAttr("aws_ses_custom_verification_email_template.test", "subject", "updated subject"),
+				),
+			},
+			{
+				ResourceName:      "aws_ses_custom_verification_email_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSesCustomVerificationEmailTemplateExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("SES custom verification email template not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("SES custom verification email template name not set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sesConn
+
+		_, err := conn.GetCustomVerificationEmailTemplate(&ses.GetCustomVerificationEmailTemplateInput{
+			TemplateName: aws.String(rs.Primary.ID),
+		})
+		return err
+	}
+}
+
+func testAccCheckSesCustomVerificationEmailTemplateDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sesConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ses_custom_verification_email_template" {
+			continue
+		}
+
+		_, err := conn.GetCustomVerificationEmailTemplate(&ses.GetCustomVerificationEmailTemplateInput{
+			TemplateName: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("SES custom verification email template %s still exists. Failing!", rs.Primary.ID)
+		}
+		if !isAWSErr(err, ses.ErrCodeCustomVerificationEmailTemplateDoesNotExistException, "") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsSesCustomVerificationEmailTemplateConfig(name, subject string) string {
+	return fmt.Sprintf(`
+resource "aws_ses_custom_verification_email_template" "test" {
+  name                    = "%s"
+  from_email_address      = "sender@example.com"
+  subject                 = "%s"
+  content                 = "<html><body>Please verify your address.</body></html>"
+  success_redirection_url = "https://example.com/success"
+  failure_redirection_url = "https://example.com/failure"
+}
+`, name, subject)
+}
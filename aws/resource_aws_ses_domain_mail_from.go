@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSesDomainMailFrom() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSesDomainMailFromSet,
+		Read:   resourceAwsSesDomainMailFromRead,
+		Update: resourceAwsSesDomainMailFromSet,
+		Delete: resourceAwsSesDomainMailFromDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"mail_from_domain": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"behavior_on_mx_failure": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ses.BehaviorOnMXFailureUseDefaultValue,
+				ValidateFunc: validation.StringInSlice([]string{
+					ses.BehaviorOnMXFailureUseDefaultValue,
+					ses.BehaviorOnMXFailureRejectMessage,
+				}, false),
+			},
+			"mail_from_domain_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSesDomainMailFromSet(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesConn
+
+	domainName := d.Get("domain").(string)
+
+	input := &ses.SetIdentityMailFromDomainInput{
+		BehaviorOnMXFailure: aws.String(d.Get("behavior_on_mx_failure").(string)),
+		Identity:            aws.String(domainName),
+		MailFromDomain:      aws.String(d.Get("mail_from_domain").(string)),
+	}
+
+	log.Printf("[DEBUG] Setting SES domain MAIL FROM: %#v", input)
+	if _, err := conn.SetIdentityMailFromDomain(input); err != nil {
+		return fmt.Errorf("Error setting MAIL FROM domain: %s", err)
+	}
+
+	d.SetId(domainName)
+
+	return resourceAwsSesDomainMailFromRead(d, meta)
+}
+
+func resourceAwsSesDomainMailFromRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesConn
+
+	domainName := d.Id()
+
+	readOpts := &ses.GetIdentityMailFromDomainAttributesInput{
+		Identities: []*string{
+			aws.String(domainName),
+		},
+	}
+
+	response, err := conn.GetIdentityMailFromDomainAttributes(readOpts)
+	if err != nil {
+		log.Printf("[WARN] Error fetching identity MAIL FROM attributes for %s: %s", d.Id(), err)
+		return err
+	}
+
+	attributes, ok := response.MailFromDomainAttributes[domainName]
+	if !ok || aws.StringValue(attributes.MailFromDomain) == "" {
+		log.Printf("[WARN] SES Domain MAIL FROM (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("domain", domainName)
+	d.Set("mail_from_domain", attributes.MailFromDomain)
+	d.Set("behavior_on_mx_failure", attributes.BehaviorOnMXFailure)
+	d.Set("mail_from_domain_status", attributes.MailFromDomainStatus)
+
+	return nil
+}
+
+func resourceAwsSesDomainMailFromDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesConn
+
+	// Setting no MAIL FROM domain reverts to the default amazonses.com domain.
+	input := &ses.SetIdentityMailFromDomainInput{
+		Identity:       aws.String(d.Id()),
+		MailFromDomain: nil,
+	}
+
+	log.Printf("[DEBUG] Deleting SES domain MAIL FROM: %#v", input)
+	if _, err := conn.SetIdentityMailFromDomain(input); err != nil {
+		return fmt.Errorf("Error deleting SES domain MAIL FROM: %s", err)
+	}
+
+	return nil
+}
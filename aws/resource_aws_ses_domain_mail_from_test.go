@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsSESDomainMailFrom_basic(t *testing.T) {
+	domain := fmt.Sprintf(
+		"%s.terraformtesting.com",
+		acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSESDomainMailFromDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSESDomainMailFromConfig(domain, "bounce", ses.BehaviorOnMXFailureUseDefaultValue),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESDomainMailFromExists("aws_ses_domain_mail_from.test"),
+					resource.TestCheckResourceAttr("aws_ses_domain_mail_from.test", "mail_from_domain", "bounce."+domain),
+					resource.TestCheckResourceAttr("aws_ses_domain_mail_from.test", "behavior_on_mx_failure", ses.BehaviorOnMXFailureUseDefaultValue),
+				),
+			},
+			{
+				Config: testAccAwsSESDomainMailFromConfig(domain, "mail", ses.BehaviorOnMXFailureRejectMessage),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESDomainMailFromExists("aws_ses_domain_mail_from.test"),
+					resource.TestCheckResourceAttr("aws_ses_domain_mail_from.test", "mail_from_domain", "mail."+domain),
+					resource.TestCheckResourceAttr("aws_ses_domain_mail_from.test", "behavior_on_mx_failure", ses.BehaviorOnMXFailureRejectMessage),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSESDomainMailFromDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sesConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ses_domain_mail_from" {
+			continue
+		}
+
+		response, err := conn.GetIdentityMailFromDomainAttributes(&ses.GetIdentityMailFromDomainAttributesInput{
+			Identities: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return err
+		}
+
+		if attrs, ok := response.MailFromDomainAttributes[rs.Primary.ID]; ok && aws.StringValue(attrs.MailFromDomain) != "" {
+			return fmt.Errorf("SES Domain MAIL FROM %s still exists. Failing!", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsSESDomainMailFromExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("SES Domain MAIL FROM not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("SES Domain MAIL FROM domain not set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sesConn
+
+		response, err := conn.GetIdentityMailFromDomainAttributes(&ses.GetIdentityMailFromDomainAttributesInput{
+			Identities: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return err
+		}
+
+		attrs, ok := response.MailFromDomainAttributes[rs.Primary.ID]
+		if !ok || aws.StringValue(attrs.MailFromDomain) == "" {
+			return fmt.Errorf("SES Domain MAIL FROM %s not found in AWS", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsSESDomainMailFromConfig(domain, subdomain, behavior string) string {
+	return fmt.Sprintf(`
+resource "aws_ses_domain_identity" "test" {
+  domain = "%s"
+}
+
+resource "aws_ses_domain_mail_from" "test" {
+  domain                 = "${aws_ses_domain_identity.test.domain}"
+  mail_from_domain       = "%s.${aws_ses_domain_identity.test.domain}"
+  behavior_on_mx_failure = "%s"
+}
+`, domain, subdomain, behavior)
+}
@@ -0,0 +1,98 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsSesEmailIdentity() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSesEmailIdentityCreate,
+		Read:   resourceAwsSesEmailIdentityRead,
+		Delete: resourceAwsSesEmailIdentityDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSesEmailIdentityCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesConn
+
+	email := d.Get("email").(string)
+
+	createOpts := &ses.VerifyEmailIdentityInput{
+		EmailAddress: aws.String(email),
+	}
+
+	_, err := conn.VerifyEmailIdentity(createOpts)
+	if err != nil {
+		return fmt.Errorf("Error requesting SES email identity verification: %s", err)
+	}
+
+	d.SetId(email)
+
+	return resourceAwsSesEmailIdentityRead(d, meta)
+}
+
+func resourceAwsSesEmailIdentityRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesConn
+
+	email := d.Id()
+	d.Set("email", email)
+
+	readOpts := &ses.GetIdentityVerificationAttributesInput{
+		Identities: []*string{
+			aws.String(email),
+		},
+	}
+
+	response, err := conn.GetIdentityVerificationAttributes(readOpts)
+	if err != nil {
+		log.Printf("[WARN] Error fetching identity verification attributes for %s: %s", d.Id(), err)
+		return err
+	}
+
+	_, ok := response.VerificationAttributes[email]
+	if !ok {
+		log.Printf("[WARN] Email not listed in response when fetching verification attributes for %s", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", fmt.Sprintf("arn:%s:ses:%s:%s:identity/%s", meta.(*AWSClient).partition, meta.(*AWSClient).region, meta.(*AWSClient).accountid, d.Id()))
+	return nil
+}
+
+func resourceAwsSesEmailIdentityDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesConn
+
+	email := d.Get("email").(string)
+
+	deleteOpts := &ses.DeleteIdentityInput{
+		Identity: aws.String(email),
+	}
+
+	_, err := conn.DeleteIdentity(deleteOpts)
+	if err != nil {
+		return fmt.Errorf("Error deleting SES email identity: %s", err)
+	}
+
+	return nil
+}
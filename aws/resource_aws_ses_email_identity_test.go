@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsSESEmailIdentity_basic(t *testing.T) {
+	email := fmt.Sprintf(
+		"%s@terraformtesting.com",
+		acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSESEmailIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccAwsSESEmailIdentityConfig, email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESEmailIdentityExists("aws_ses_email_identity.test"),
+					resource.TestCheckResourceAttr("aws_ses_email_identity.test", "email", email),
+					resource.TestCheckResourceAttrSet("aws_ses_email_identity.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      "aws_ses_email_identity.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSESEmailIdentityDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sesConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ses_email_identity" {
+			continue
+		}
+
+		email := rs.Primary.ID
+		params := &ses.GetIdentityVerificationAttributesInput{
+			Identities: []*string{
+				aws.String(email),
+			},
+		}
+
+		response, err := conn.GetIdentityVerificationAttributes(params)
+		if err != nil {
+			return err
+		}
+
+		if response.VerificationAttributes[email] != nil {
+			return fmt.Errorf("SES Email Identity %s still exists. Failing!", email)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsSESEmailIdentityExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("SES Email Identity not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("SES Email Identity name not set")
+		}
+
+		email := rs.Primary.ID
+		conn := testAccProvider.Meta().(*AWSClient).sesConn
+
+		params := &ses.GetIdentityVerificationAttributesInput{
+			Identities: []*string{
+				aws.String(email),
+			},
+		}
+
+		response, err := conn.GetIdentityVerificationAttributes(params)
+		if err != nil {
+			return err
+		}
+
+		if response.VerificationAttributes[email] == nil {
+			return fmt.Errorf("SES Email Identity %s not found in AWS", email)
+		}
+
+		return nil
+	}
+}
+
+const testAccAwsSESEmailIdentityConfig = `
+resource "aws_ses_email_identity" "test" {
+	email = "%s"
+}
+`
@@ -0,0 +1,95 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsSesIdentityFeedbackForwarding() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSesIdentityFeedbackForwardingSet,
+		Read:   resourceAwsSesIdentityFeedbackForwardingRead,
+		Update: resourceAwsSesIdentityFeedbackForwardingSet,
+		Delete: resourceAwsSesIdentityFeedbackForwardingDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"identity": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSesIdentityFeedbackForwardingSet(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesConn
+
+	identity := d.Get("identity").(string)
+	setOpts := &ses.SetIdentityFeedbackForwardingEnabledInput{
+		ForwardingEnabled: aws.Bool(d.Get("enabled").(bool)),
+		Identity:          aws.String(identity),
+	}
+
+	log.Printf("[DEBUG] Setting SES Identity feedback forwarding: %#v", setOpts)
+	if _, err := conn.SetIdentityFeedbackForwardingEnabled(setOpts); err != nil {
+		return fmt.Errorf("Error setting SES Identity feedback forwarding: %s", err)
+	}
+
+	d.SetId(identity)
+
+	return resourceAwsSesIdentityFeedbackForwardingRead(d, meta)
+}
+
+func resourceAwsSesIdentityFeedbackForwardingRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesConn
+
+	getOpts := &ses.GetIdentityNotificationAttributesInput{
+		Identities: []*string{aws.String(d.Id())},
+	}
+
+	response, err := conn.GetIdentityNotificationAttributes(getOpts)
+	if err != nil {
+		return fmt.Errorf("Error reading SES Identity feedback forwarding: %s", err)
+	}
+
+	attrs, ok := response.NotificationAttributes[d.Id()]
+	if !ok {
+		log.Printf("[WARN] SES Identity %q not found, removing feedback forwarding from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("identity", d.Id())
+	d.Set("enabled", attrs.ForwardingEnabled)
+
+	return nil
+}
+
+func resourceAwsSesIdentityFeedbackForwardingDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesConn
+
+	// Feedback forwarding is enabled by default, so restore that on delete.
+	setOpts := &ses.SetIdentityFeedbackForwardingEnabledInput{
+		ForwardingEnabled: aws.Bool(true),
+		Identity:          aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Resetting SES Identity feedback forwarding: %#v", setOpts)
+	if _, err := conn.SetIdentityFeedbackForwardingEnabled(setOpts); err != nil {
+		return fmt.Errorf("Error resetting SES Identity feedback forwarding: %s", err)
+	}
+
+	return nil
+}
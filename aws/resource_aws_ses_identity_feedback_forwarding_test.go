@@ -0,0 +1,142 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsSESIdentityFeedbackForwarding_basic(t *testing.T) {
+	domain := fmt.Sprintf(
+		"%s.terraformtesting.com",
+		acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	topicName := fmt.Sprintf("tf-test-ses-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSESIdentityFeedbackForwardingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSESIdentityFeedbackForwardingConfig(domain, topicName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESIdentityFeedbackForwardingEnabled(domain, false),
+					resource.TestCheckResourceAttr("aws_ses_identity_feedback_forwarding.test", "enabled", "false"),
+				),
+			},
+			{
+				Config: testAccAwsSESIdentityFeedbackForwardingConfig(domain, topicName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESIdentityFeedbackForwardingEnabled(domain, true),
+					resource.TestCheckResourceAttr("aws_ses_identity_feedback_forwarding.test", "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccAwsSESIdentityFeedbackForwardingConfig(domain, topicName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESIdentityFeedbackForwardingEnabled(domain, false),
+				),
+			},
+			// Destroying the resource resets forwarding to the SES default
+			{
+				Config: testAccAwsSESIdentityFeedbackForwardingConfig_topicsOnly(domain, topicName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESIdentityFeedbackForwardingEnabled(domain, true),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSESIdentityFeedbackForwardingDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sesConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ses_identity_feedback_forwarding" {
+			continue
+		}
+
+		response, err := conn.GetIdentityNotificationAttributes(&ses.GetIdentityNotificationAttributesInput{
+			Identities: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return err
+		}
+
+		if attrs, ok := response.NotificationAttributes[rs.Primary.ID]; ok && !aws.BoolValue(attrs.ForwardingEnabled) {
+			return fmt.Errorf("SES Identity %s feedback forwarding is still disabled. Failing!", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsSESIdentityFeedbackForwardingEnabled(identity string, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).sesConn
+
+		response, err := conn.GetIdentityNotificationAttributes(&ses.GetIdentityNotificationAttributesInput{
+			Identities: []*string{aws.String(identity)},
+		})
+		if err != nil {
+			return err
+		}
+
+		attrs, ok := response.NotificationAttributes[identity]
+		if !ok {
+			return fmt.Errorf("SES Identity %s not found in AWS", identity)
+		}
+		if aws.BoolValue(attrs.ForwardingEnabled) != expected {
+			return fmt.Errorf("SES Identity %s feedback forwarding is %t, expected %t", identity, aws.BoolValue(attrs.ForwardingEnabled), expected)
+		}
+
+		return nil
+	}
+}
+
+// Feedback forwarding can only be disabled once bounce and complaint
+// notification topics are set on the identity.
+func testAccAwsSESIdentityFeedbackForwardingConfig_topicsOnly(domain, topicName string) string {
+	return fmt.Sprintf(`
+resource "aws_ses_domain_identity" "test" {
+  domain = "%s"
+}
+
+resource "aws_sns_topic" "test" {
+  name = "%s"
+}
+
+resource "aws_ses_identity_notification_topic" "bounce" {
+  identity          = "${aws_ses_domain_identity.test.domain}"
+  notification_type = "Bounce"
+  topic_arn         = "${aws_sns_topic.test.arn}"
+}
+
+resource "aws_ses_identity_notification_topic" "complaint" {
+  identity          = "${aws_ses_domain_identity.test.domain}"
+  notification_type = "Complaint"
+  topic_arn         = "${aws_sns_topic.test.arn}"
+}
+`, domain, topicName)
+}
+
+func testAccAwsSESIdentityFeedbackForwardingConfig(domain, topicName string, enabled bool) string {
+	return testAccAwsSESIdentityFeedbackForwardingConfig_topicsOnly(domain, topicName) + fmt.Sprintf(`
+resource "aws_ses_identity_feedback_forwarding" "test" {
+  depends_on = [
+    "aws_ses_identity_notification_topic.bounce",
+    "aws_ses_identity_notification_topic.complaint",
+  ]
+
+  identity = "${aws_ses_domain_identity.test.domain}"
+  enabled  = %t
+}
+`, enabled)
+}
@@ -0,0 +1,160 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSesIdentityNotificationTopic() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSesIdentityNotificationTopicSet,
+		Read:   resourceAwsSesIdentityNotificationTopicRead,
+		Update: resourceAwsSesIdentityNotificationTopicSet,
+		Delete: resourceAwsSesIdentityNotificationTopicDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"identity": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"notification_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ses.NotificationTypeBounce,
+					ses.NotificationTypeComplaint,
+					ses.NotificationTypeDelivery,
+				}, false),
+			},
+			"topic_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"include_original_headers": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceAwsSesIdentityNotificationTopicSet(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesConn
+
+	identity := d.Get("identity").(string)
+	notificationType := d.Get("notification_type").(string)
+
+	setOpts := &ses.SetIdentityNotificationTopicInput{
+		Identity:         aws.String(identity),
+		NotificationType: aws.String(notificationType),
+	}
+	if v, ok := d.GetOk("topic_arn"); ok {
+		setOpts.SnsTopic = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Setting SES Identity Notification Topic: %#v", setOpts)
+	if _, err := conn.SetIdentityNotificationTopic(setOpts); err != nil {
+		return fmt.Errorf("Error setting SES Identity Notification Topic: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", identity, notificationType))
+
+	headersOpts := &ses.SetIdentityHeadersInNotificationsEnabledInput{
+		Enabled:          aws.Bool(d.Get("include_original_headers").(bool)),
+		Identity:         aws.String(identity),
+		NotificationType: aws.String(notificationType),
+	}
+
+	log.Printf("[DEBUG] Setting SES Identity headers in notifications: %#v", headersOpts)
+	if _, err := conn.SetIdentityHeadersInNotificationsEnabled(headersOpts); err != nil {
+		return fmt.Errorf("Error setting SES Identity headers in notifications: %s", err)
+	}
+
+	return resourceAwsSesIdentityNotificationTopicRead(d, meta)
+}
+
+func resourceAwsSesIdentityNotificationTopicRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesConn
+
+	identity, notificationType, err := decodeSesIdentityNotificationTopicID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	getOpts := &ses.GetIdentityNotificationAttributesInput{
+		Identities: []*string{aws.String(identity)},
+	}
+
+	log.Printf("[DEBUG] Reading SES Identity Notification Topic: %#v", getOpts)
+	response, err := conn.GetIdentityNotificationAttributes(getOpts)
+	if err != nil {
+		return fmt.Errorf("Error reading SES Identity Notification Topic: %s", err)
+	}
+
+	attrs, ok := response.NotificationAttributes[identity]
+	if !ok {
+		log.Printf("[WARN] SES Identity %q not found, removing notification topic from state", identity)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("identity", identity)
+	d.Set("notification_type", notificationType)
+
+	switch notificationType {
+	case ses.NotificationTypeBounce:
+		d.Set("topic_arn", attrs.BounceTopic)
+		d.Set("include_original_headers", aws.BoolValue(attrs.HeadersInBounceNotificationsEnabled))
+	case ses.NotificationTypeComplaint:
+		d.Set("topic_arn", attrs.ComplaintTopic)
+		d.Set("include_original_headers", aws.BoolValue(attrs.HeadersInComplaintNotificationsEnabled))
+	case ses.NotificationTypeDelivery:
+		d.Set("topic_arn", attrs.DeliveryTopic)
+		d.Set("include_original_headers", aws.BoolValue(attrs.HeadersInDeliveryNotificationsEnabled))
+	}
+
+	return nil
+}
+
+func resourceAwsSesIdentityNotificationTopicDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesConn
+
+	identity, notificationType, err := decodeSesIdentityNotificationTopicID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Setting the topic without an ARN disables publishing to SNS.
+	setOpts := &ses.SetIdentityNotificationTopicInput{
+		Identity:         aws.String(identity),
+		NotificationType: aws.String(notificationType),
+	}
+
+	log.Printf("[DEBUG] Deleting SES Identity Notification Topic: %#v", setOpts)
+	if _, err := conn.SetIdentityNotificationTopic(setOpts); err != nil {
+		return fmt.Errorf("Error deleting SES Identity Notification Topic: %s", err)
+	}
+
+	return nil
+}
+
+func decodeSesIdentityNotificationTopicID(id string) (string, string, error) {
+	parts := strings.Split(id, "|")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("SES Identity Notification Topic ID must be of the form <identity>|<notification_type>, was provided: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
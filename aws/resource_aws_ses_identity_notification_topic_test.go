@@ -0,0 +1,163 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDecodeSesIdentityNotificationTopicID(t *testing.T) {
+	var testCases = []struct {
+		Input            string
+		Identity         string
+		NotificationType string
+		ErrCount         int
+	}{
+		{
+			Input:    "",
+			ErrCount: 1,
+		},
+		{
+			Input:    "example.com",
+			ErrCount: 1,
+		},
+		{
+			Input:    "example.com|",
+			ErrCount: 1,
+		},
+		{
+			Input:            "example.com|Bounce",
+			Identity:         "example.com",
+			NotificationType: "Bounce",
+			ErrCount:         0,
+		},
+	}
+
+	for _, tc := range testCases {
+		identity, notificationType, err := decodeSesIdentityNotificationTopicID(tc.Input)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.Input, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.Input)
+		}
+		if identity != tc.Identity {
+			t.Fatalf("expected %q to return identity %q, received: %q", tc.Input, tc.Identity, identity)
+		}
+		if notificationType != tc.NotificationType {
+			t.Fatalf("expected %q to return notification type %q, received: %q", tc.Input, tc.NotificationType, notificationType)
+		}
+	}
+}
+
+func TestAccAwsSESIdentityNotificationTopic_basic(t *testing.T) {
+	domain := fmt.Sprintf(
+		"%s.terraformtesting.com",
+		acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	topicName := fmt.Sprintf("tf-test-ses-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSESIdentityNotificationTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSESIdentityNotificationTopicConfig(domain, topicName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESIdentityNotificationTopicExists("aws_ses_identity_notification_topic.test"),
+					resource.TestCheckResourceAttr("aws_ses_identity_notification_topic.test", "include_original_headers", "false"),
+				),
+			},
+			{
+				Config: testAccAwsSESIdentityNotificationTopicConfig(domain, topicName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESIdentityNotificationTopicExists("aws_ses_identity_notification_topic.test"),
+					resource.TestCheckResourceAttr("aws_ses_identity_notification_topic.test", "include_original_headers", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSESIdentityNotificationTopicDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sesConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ses_identity_notification_topic" {
+			continue
+		}
+
+		identity := rs.Primary.Attributes["identity"]
+		response, err := conn.GetIdentityNotificationAttributes(&ses.GetIdentityNotificationAttributesInput{
+			Identities: []*string{aws.String(identity)},
+		})
+		if err != nil {
+			return err
+		}
+
+		if attrs, ok := response.NotificationAttributes[identity]; ok && aws.StringValue(attrs.BounceTopic) != "" {
+			return fmt.Errorf("SES Identity Notification Topic %s still exists. Failing!", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsSESIdentityNotificationTopicExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("SES Identity Notification Topic not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("SES Identity Notification Topic ID not set")
+		}
+
+		identity := rs.Primary.Attributes["identity"]
+		conn := testAccProvider.Meta().(*AWSClient).sesConn
+
+		response, err := conn.GetIdentityNotificationAttributes(&ses.GetIdentityNotificationAttributesInput{
+			Identities: []*string{aws.String(identity)},
+		})
+		if err != nil {
+			return err
+		}
+
+		attrs, ok := response.NotificationAttributes[identity]
+		if !ok {
+			return fmt.Errorf("SES Identity %s not found in AWS", identity)
+		}
+		if aws.StringValue(attrs.BounceTopic) != rs.Primary.Attributes["topic_arn"] {
+			return fmt.Errorf("SES Identity %s bounce topic is %q, expected %q", identity, aws.StringValue(attrs.BounceTopic), rs.Primary.Attributes["topic_arn"])
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsSESIdentityNotificationTopicConfig(domain, topicName string, includeHeaders bool) string {
+	return fmt.Sprintf(`
+resource "aws_ses_domain_identity" "test" {
+  domain = "%s"
+}
+
+resource "aws_sns_topic" "test" {
+  name = "%s"
+}
+
+resource "aws_ses_identity_notification_topic" "test" {
+  identity                 = "${aws_ses_domain_identity.test.domain}"
+  notification_type        = "Bounce"
+  topic_arn                = "${aws_sns_topic.test.arn}"
+  include_original_headers = %t
+}
+`, domain, topicName, includeHeaders)
+}
@@ -0,0 +1,126 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsSesIdentityPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSesIdentityPolicyPut,
+		Read:   resourceAwsSesIdentityPolicyRead,
+		Update: resourceAwsSesIdentityPolicyPut,
+		Delete: resourceAwsSesIdentityPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"identity": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateIAMPolicyJson,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+			},
+		},
+	}
+}
+
+func resourceAwsSesIdentityPolicyPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesConn
+
+	identity := d.Get("identity").(string)
+	policyName := d.Get("name").(string)
+
+	input := &ses.PutIdentityPolicyInput{
+		Identity:   aws.String(identity),
+		Policy:     aws.String(d.Get("policy").(string)),
+		PolicyName: aws.String(policyName),
+	}
+
+	log.Printf("[DEBUG] Putting SES Identity Policy: %#v", input)
+	if _, err := conn.PutIdentityPolicy(input); err != nil {
+		return fmt.Errorf("Error putting SES Identity Policy: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", identity, policyName))
+
+	return resourceAwsSesIdentityPolicyRead(d, meta)
+}
+
+func resourceAwsSesIdentityPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesConn
+
+	identity, policyName, err := decodeSesIdentityPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &ses.GetIdentityPoliciesInput{
+		Identity:    aws.String(identity),
+		PolicyNames: aws.StringSlice([]string{policyName}),
+	}
+
+	log.Printf("[DEBUG] Reading SES Identity Policy: %#v", input)
+	response, err := conn.GetIdentityPolicies(input)
+	if err != nil {
+		return fmt.Errorf("Error reading SES Identity Policy: %s", err)
+	}
+
+	policy, ok := response.Policies[policyName]
+	if !ok {
+		log.Printf("[WARN] SES Identity Policy %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("identity", identity)
+	d.Set("name", policyName)
+	d.Set("policy", policy)
+
+	return nil
+}
+
+func resourceAwsSesIdentityPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesConn
+
+	identity, policyName, err := decodeSesIdentityPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &ses.DeleteIdentityPolicyInput{
+		Identity:   aws.String(identity),
+		PolicyName: aws.String(policyName),
+	}
+
+	log.Printf("[DEBUG] Deleting SES Identity Policy: %#v", input)
+	if _, err := conn.DeleteIdentityPolicy(input); err != nil {
+		return fmt.Errorf("Error deleting SES Identity Policy: %s", err)
+	}
+
+	return nil
+}
+
+func decodeSesIdentityPolicyID(id string) (string, string, error) {
+	parts := strings.Split(id, "|")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("SES Identity Policy ID must be of the form <identity>|<policy_name>, was provided: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,131 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsSESIdentityPolicy_basic(t *testing.T) {
+	domain := fmt.Sprintf(
+		"%s.terraformtesting.com",
+		acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSESIdentityPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSESIdentityPolicyConfig(domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESIdentityPolicyExists("aws_ses_identity_policy.test"),
+				),
+			},
+			{
+				ResourceName:      "aws_ses_identity_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSESIdentityPolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sesConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ses_identity_policy" {
+			continue
+		}
+
+		identity, policyName, err := decodeSesIdentityPolicyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		response, err := conn.GetIdentityPolicies(&ses.GetIdentityPoliciesInput{
+			Identity:    aws.String(identity),
+			PolicyNames: aws.StringSlice([]string{policyName}),
+		})
+		if err != nil {
+			return err
+		}
+
+		if _, ok := response.Policies[policyName]; ok {
+			return fmt.Errorf("SES Identity Policy %s still exists. Failing!", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsSESIdentityPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("SES Identity Policy not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("SES Identity Policy ID not set")
+		}
+
+		identity, policyName, err := decodeSesIdentityPolicyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sesConn
+
+		response, err := conn.GetIdentityPolicies(&ses.GetIdentityPoliciesInput{
+			Identity:    aws.String(identity),
+			PolicyNames: aws.StringSlice([]string{policyName}),
+		})
+		if err != nil {
+			return err
+		}
+
+		if _, ok := response.Policies[policyName]; !ok {
+			return fmt.Errorf("SES Identity Policy %s not found in AWS", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsSESIdentityPolicyConfig(domain string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_ses_domain_identity" "test" {
+  domain = "%s"
+}
+
+resource "aws_ses_identity_policy" "test" {
+  identity = "${aws_ses_domain_identity.test.domain}"
+  name     = "test"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:aws:iam::${data.aws_caller_identity.current.account_id}:root"},
+      "Action": ["ses:SendEmail", "ses:SendRawEmail"],
+      "Resource": "${aws_ses_domain_identity.test.arn}"
+    }
+  ]
+}
+POLICY
+}
+`, domain)
+}
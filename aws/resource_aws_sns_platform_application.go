@@ -0,0 +1,265 @@
+package aws
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// Mutable attributes, excluding the credentials which are only ever stored hashed
+var SNSPlatformApplicationAttributeMap = map[string]string{
+	"event_delivery_failure_topic_arn": "EventDeliveryFailure",
+	"event_endpoint_created_topic_arn": "EventEndpointCreated",
+	"event_endpoint_deleted_topic_arn": "EventEndpointDeleted",
+	"event_endpoint_updated_topic_arn": "EventEndpointUpdated",
+	"failure_feedback_role_arn":        "FailureFeedbackRoleArn",
+	"success_feedback_role_arn":        "SuccessFeedbackRoleArn",
+	"success_feedback_sample_rate":     "SuccessFeedbackSampleRate",
+}
+
+func resourceAwsSnsPlatformApplication() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSnsPlatformApplicationCreate,
+		Read:   resourceAwsSnsPlatformApplicationRead,
+		Update: resourceAwsSnsPlatformApplicationUpdate,
+		Delete: resourceAwsSnsPlatformApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"platform": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"APNS",
+					"APNS_SANDBOX",
+					"GCM",
+				}, false),
+			},
+			"platform_credential": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+				StateFunc: hashSnsPlatformApplicationSecret,
+			},
+			"platform_principal": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+				StateFunc: hashSnsPlatformApplicationSecret,
+			},
+			"event_delivery_failure_topic_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"event_endpoint_created_topic_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"event_endpoint_deleted_topic_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"event_endpoint_updated_topic_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"failure_feedback_role_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"success_feedback_role_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"success_feedback_sample_rate": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSnsPlatformApplicationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).snsconn
+
+	attributes := map[string]*string{
+		"PlatformCredential": aws.String(d.Get("platform_credential").(string)),
+	}
+	if v, ok := d.GetOk("platform_principal"); ok {
+		attributes["PlatformPrincipal"] = aws.String(v.(string))
+	}
+	for k, attrKey := range SNSPlatformApplicationAttributeMap {
+		if v, ok := d.GetOk(k); ok {
+			attributes[attrKey] = aws.String(fmt.Sprintf("%v", v))
+		}
+	}
+
+	input := &sns.CreatePlatformApplicationInput{
+		Name:       aws.String(d.Get("name").(string)),
+		Platform:   aws.String(d.Get("platform").(string)),
+		Attributes: attributes,
+	}
+
+	// Don't log the input, it contains the platform credentials
+	log.Printf("[DEBUG] Creating SNS platform application: %s", d.Get("name").(string))
+	// Retry in case a newly created feedback IAM role is not yet usable
+	output, err := retryOnAwsCode(sns.ErrCodeInvalidParameterException, func() (interface{}, error) {
+		return conn.CreatePlatformApplication(input)
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating SNS platform application: %s", err)
+	}
+
+	d.SetId(*output.(*sns.CreatePlatformApplicationOutput).PlatformApplicationArn)
+
+	return resourceAwsSnsPlatformApplicationRead(d, meta)
+}
+
+func resourceAwsSnsPlatformApplicationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).snsconn
+
+	output, err := conn.GetPlatformApplicationAttributes(&sns.GetPlatformApplicationAttributesInput{
+		PlatformApplicationArn: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, sns.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] SNS platform application (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading SNS platform application (%s): %s", d.Id(), err)
+	}
+
+	name, platform, err := decodeResourceAwsSnsPlatformApplicationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("arn", d.Id())
+	d.Set("name", name)
+	d.Set("platform", platform)
+
+	// The credentials are never returned, so their hashes are kept as-is
+	for k, attrKey := range SNSPlatformApplicationAttributeMap {
+		value := aws.StringValue(output.Attributes[attrKey])
+		if k == "success_feedback_sample_rate" {
+			if value == "" {
+				d.Set(k, 0)
+				continue
+			}
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("Error parsing SNS platform application attribute %s (%q): %s", attrKey, value, err)
+			}
+			d.Set(k, n)
+			continue
+		}
+		d.Set(k, value)
+	}
+
+	return nil
+}
+
+func resourceAwsSnsPlatformApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).snsconn
+
+	attributes := make(map[string]*string)
+
+	// Only the hashes of the credentials are kept in state, so an unchanged
+	// credential can't be resent. APNS requires the certificate and private
+	// key to be supplied together.
+	if d.HasChange("platform_credential") || d.HasChange("platform_principal") {
+		if _, ok := d.GetOk("platform_principal"); ok && !(d.HasChange("platform_credential") && d.HasChange("platform_principal")) {
+			return fmt.Errorf("platform_credential and platform_principal must be updated together")
+		}
+		attributes["PlatformCredential"] = aws.String(d.Get("platform_credential").(string))
+		if v, ok := d.GetOk("platform_principal"); ok {
+			attributes["PlatformPrincipal"] = aws.String(v.(string))
+		}
+	}
+
+	for k, attrKey := range SNSPlatformApplicationAttributeMap {
+		if d.HasChange(k) {
+			attributes[attrKey] = aws.String(fmt.Sprintf("%v", d.Get(k)))
+		}
+	}
+
+	if len(attributes) > 0 {
+		log.Printf("[DEBUG] Updating SNS platform application: %s", d.Id())
+		_, err := retryOnAwsCode(sns.ErrCodeInvalidParameterException, func() (interface{}, error) {
+			return conn.SetPlatformApplicationAttributes(&sns.SetPlatformApplicationAttributesInput{
+				PlatformApplicationArn: aws.String(d.Id()),
+				Attributes:             attributes,
+			})
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating SNS platform application (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsSnsPlatformApplicationRead(d, meta)
+}
+
+func resourceAwsSnsPlatformApplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).snsconn
+
+	log.Printf("[DEBUG] Deleting SNS platform application: %s", d.Id())
+	_, err := conn.DeletePlatformApplication(&sns.DeletePlatformApplicationInput{
+		PlatformApplicationArn: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, sns.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting SNS platform application (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// decodeResourceAwsSnsPlatformApplicationID returns the name and platform
+// from an ARN such as arn:aws:sns:us-west-2:123456789012:app/GCM/example.
+func decodeResourceAwsSnsPlatformApplicationID(input string) (string, string, error) {
+	parts := strings.SplitN(input, ":", 6)
+	if len(parts) != 6 {
+		return "", "", fmt.Errorf("Unexpected format of SNS platform application ARN (%s)", input)
+	}
+
+	resourceParts := strings.Split(parts[5], "/")
+	if len(resourceParts) != 3 || resourceParts[0] != "app" || resourceParts[1] == "" || resourceParts[2] == "" {
+		return "", "", fmt.Errorf("Unexpected format of SNS platform application ARN (%s), expected resource app/PLATFORM/NAME", input)
+	}
+
+	return resourceParts[2], resourceParts[1], nil
+}
+
+func hashSnsPlatformApplicationSecret(v interface{}) string {
+	switch v.(type) {
+	case string:
+		hash := sha256.Sum256([]byte(v.(string)))
+		return hex.EncodeToString(hash[:])
+	default:
+		return ""
+	}
+}
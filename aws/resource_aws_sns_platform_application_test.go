@@ -0,0 +1,233 @@
+package aws
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDecodeResourceAwsSnsPlatformApplicationID(t *testing.T) {
+	cases := []struct {
+		ID       string
+		Name     string
+		Platform string
+		ErrCount int
+	}{
+		{
+			ID:       "arn:aws:sns:us-east-1:123456789012:app/APNS_SANDBOX/example",
+			Name:     "example",
+			Platform: "APNS_SANDBOX",
+		},
+		{
+			ID:       "arn:aws:sns:us-east-1:123456789012:app/GCM/example",
+			Name:     "example",
+			Platform: "GCM",
+		},
+		{
+			ID:       "arn:aws:sns:us-east-1:123456789012:app/GCM",
+			ErrCount: 1,
+		},
+		{
+			ID:       "arn:aws:sns:us-east-1:123456789012:example",
+			ErrCount: 1,
+		},
+		{
+			ID:       "example",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		name, platform, err := decodeResourceAwsSnsPlatformApplicationID(tc.ID)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.ID, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.ID)
+		}
+		if name != tc.Name || platform != tc.Platform {
+			t.Fatalf("expected %q to decode to (%q, %q), received: (%q, %q)", tc.ID, tc.Name, tc.Platform, name, platform)
+		}
+	}
+}
+
+func TestAccAWSSnsPlatformApplication_gcm(t *testing.T) {
+	apiKey := os.Getenv("GCM_API_KEY")
+	if apiKey == "" {
+		t.Skip("Environment variable GCM_API_KEY is not set")
+	}
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sns_platform_application.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSNSPlatformApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSnsPlatformApplicationConfig_gcm(rName, apiKey, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSnsPlatformApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "platform", "GCM"),
+					resource.TestCheckResourceAttr(resourceName, "platform_credential", hashSnsPlatformApplicationSecret(apiKey)),
+					resource.TestCheckResourceAttrPair(resourceName, "event_endpoint_created_topic_arn", "aws_sns_topic.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "success_feedback_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "success_feedback_sample_rate", "100"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+				),
+			},
+			{
+				Config: testAccAwsSnsPlatformApplicationConfig_gcm(rName, apiKey, 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSnsPlatformApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "success_feedback_sample_rate", "50"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"platform_credential"},
+			},
+		},
+	})
+}
+
+func TestAccAWSSnsPlatformApplication_apnsSandbox(t *testing.T) {
+	certificatePath := os.Getenv("APNS_SANDBOX_CREDENTIAL_PATH")
+	privateKeyPath := os.Getenv("APNS_SANDBOX_PRINCIPAL_PATH")
+	if certificatePath == "" || privateKeyPath == "" {
+		t.Skip("Environment variables APNS_SANDBOX_CREDENTIAL_PATH and APNS_SANDBOX_PRINCIPAL_PATH are not set")
+	}
+
+	credential, err := ioutil.ReadFile(privateKeyPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	principal, err := ioutil.ReadFile(certificatePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sns_platform_application.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSNSPlatformApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSnsPlatformApplicationConfig_apnsSandbox(rName, privateKeyPath, certificatePath),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSnsPlatformApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "platform", "APNS_SANDBOX"),
+					resource.TestCheckResourceAttr(resourceName, "platform_credential", hashSnsPlatformApplicationSecret(string(credential))),
+					resource.TestCheckResourceAttr(resourceName, "platform_principal", hashSnsPlatformApplicationSecret(string(principal))),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSnsPlatformApplicationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SNS platform application ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).snsconn
+
+		_, err := conn.GetPlatformApplicationAttributes(&sns.GetPlatformApplicationAttributesInput{
+			PlatformApplicationArn: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSSNSPlatformApplicationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).snsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sns_platform_application" {
+			continue
+		}
+
+		_, err := conn.GetPlatformApplicationAttributes(&sns.GetPlatformApplicationAttributesInput{
+			PlatformApplicationArn: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, sns.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("SNS platform application %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAwsSnsPlatformApplicationConfig_gcm(rName, apiKey string, sampleRate int) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = "%s"
+}
+
+resource "aws_iam_role" "test" {
+  name = "%s"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "sns.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_sns_platform_application" "test" {
+  name                             = "%s"
+  platform                         = "GCM"
+  platform_credential              = "%s"
+  event_endpoint_created_topic_arn = "${aws_sns_topic.test.arn}"
+  success_feedback_role_arn        = "${aws_iam_role.test.arn}"
+  failure_feedback_role_arn        = "${aws_iam_role.test.arn}"
+  success_feedback_sample_rate     = %d
+}
+`, rName, rName, rName, apiKey, sampleRate)
+}
+
+func testAccAwsSnsPlatformApplicationConfig_apnsSandbox(rName, credentialPath, principalPath string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_platform_application" "test" {
+  name                = "%s"
+  platform            = "APNS_SANDBOX"
+  platform_credential = "${file("%s")}"
+  platform_principal  = "${file("%s")}"
+}
+`, rName, credentialPath, principalPath)
+}
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -10,14 +11,27 @@ import (
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 // Mutable attributes
 var SNSAttributeMap = map[string]string{
-	"arn":             "TopicArn",
-	"display_name":    "DisplayName",
-	"policy":          "Policy",
-	"delivery_policy": "DeliveryPolicy",
+	"arn":                                   "TopicArn",
+	"display_name":                          "DisplayName",
+	"policy":                                "Policy",
+	"delivery_policy":                       "DeliveryPolicy",
+	"application_success_feedback_role_arn": "ApplicationSuccessFeedbackRoleArn",
+	"application_success_feedback_sample_rate": "ApplicationSuccessFeedbackSampleRate",
+	"application_failure_feedback_role_arn":    "ApplicationFailureFeedbackRoleArn",
+	"http_success_feedback_role_arn":           "HTTPSuccessFeedbackRoleArn",
+	"http_success_feedback_sample_rate":        "HTTPSuccessFeedbackSampleRate",
+	"http_failure_feedback_role_arn":           "HTTPFailureFeedbackRoleArn",
+	"lambda_success_feedback_role_arn":         "LambdaSuccessFeedbackRoleArn",
+	"lambda_success_feedback_sample_rate":      "LambdaSuccessFeedbackSampleRate",
+	"lambda_failure_feedback_role_arn":         "LambdaFailureFeedbackRoleArn",
+	"sqs_success_feedback_role_arn":            "SQSSuccessFeedbackRoleArn",
+	"sqs_success_feedback_sample_rate":         "SQSSuccessFeedbackSampleRate",
+	"sqs_failure_feedback_role_arn":            "SQSFailureFeedbackRoleArn",
 }
 
 func resourceAwsSnsTopic() *schema.Resource {
@@ -63,6 +77,58 @@ func resourceAwsSnsTopic() *schema.Resource {
 					return json
 				},
 			},
+			"application_success_feedback_role_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"application_success_feedback_sample_rate": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"application_failure_feedback_role_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"http_success_feedback_role_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"http_success_feedback_sample_rate": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"http_failure_feedback_role_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"lambda_success_feedback_role_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"lambda_success_feedback_sample_rate": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"lambda_failure_feedback_role_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sqs_success_feedback_role_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sqs_success_feedback_sample_rate": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"sqs_failure_feedback_role_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"arn": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
@@ -109,7 +175,7 @@ func resourceAwsSnsTopicUpdate(d *schema.ResourceData, meta interface{}) error {
 					req := sns.SetTopicAttributesInput{
 						TopicArn:       aws.String(d.Id()),
 						AttributeName:  aws.String(attrKey),
-						AttributeValue: aws.String(n.(string)),
+						AttributeValue: aws.String(fmt.Sprintf("%v", n)),
 					}
 					conn := meta.(*AWSClient).snsconn
 					// Retry the update in the event of an eventually consistent style of
@@ -118,7 +184,9 @@ func resourceAwsSnsTopicUpdate(d *schema.ResourceData, meta interface{}) error {
 					_, err := retryOnAwsCode("InvalidParameter", func() (interface{}, error) {
 						return conn.SetTopicAttributes(&req)
 					})
-					return err
+					if err != nil {
+						return err
+					}
 				}
 			}
 		}
@@ -164,7 +232,15 @@ func resourceAwsSnsTopicRead(d *schema.ResourceData, meta interface{}) error {
 						value = *attrmap[oKey]
 					}
 					log.Printf("[DEBUG] Reading %s => %s -> %s", iKey, oKey, value)
-					d.Set(iKey, value)
+					if resource.Schema[iKey].Type == schema.TypeInt {
+						n, err := strconv.Atoi(value)
+						if err != nil {
+							return fmt.Errorf("Error parsing SNS topic attribute %s (%q): %s", oKey, value, err)
+						}
+						d.Set(iKey, n)
+					} else {
+						d.Set(iKey, value)
+					}
 				}
 			}
 		}
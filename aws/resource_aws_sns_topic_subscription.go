@@ -24,6 +24,7 @@ var SNSSubscriptionAttributeMap = map[string]string{
 	"endpoint":             "Endpoint",
 	"protocol":             "Protocol",
 	"raw_message_delivery": "RawMessageDelivery",
+	"filter_policy":        "FilterPolicy",
 }
 
 func resourceAwsSnsTopicSubscription() *schema.Resource {
@@ -70,6 +71,16 @@ func resourceAwsSnsTopicSubscription() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"filter_policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := normalizeJsonString(v)
+					return json
+				},
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -87,16 +98,7 @@ func resourceAwsSnsTopicSubscriptionCreate(d *schema.ResourceData, meta interfac
 		return err
 	}
 
-	if subscriptionHasPendingConfirmation(output.SubscriptionArn) {
-		log.Printf("[WARN] Invalid SNS Subscription, received a \"%s\" ARN", awsSNSPendingConfirmationMessage)
-		return nil
-	}
-
-	log.Printf("New subscription ARN: %s", *output.SubscriptionArn)
-	d.SetId(*output.SubscriptionArn)
-
-	// Write the ARN to the 'arn' field for export
-	d.Set("arn", *output.SubscriptionArn)
+	setSnsTopicSubscriptionId(d, output.SubscriptionArn)
 
 	return resourceAwsSnsTopicSubscriptionUpdate(d, meta)
 }
@@ -107,19 +109,29 @@ func resourceAwsSnsTopicSubscriptionUpdate(d *schema.ResourceData, meta interfac
 	// If any changes happened, un-subscribe and re-subscribe
 	if !d.IsNewResource() && (d.HasChange("protocol") || d.HasChange("endpoint") || d.HasChange("topic_arn")) {
 		log.Printf("[DEBUG] Updating subscription %s", d.Id())
-		// Unsubscribe
-		_, err := snsconn.Unsubscribe(&sns.UnsubscribeInput{
-			SubscriptionArn: aws.String(d.Id()),
-		})
+		// Unsubscribe; pending subscriptions cannot be removed and simply expire
+		if !isSnsTopicSubscriptionPendingId(d.Id()) {
+			_, err := snsconn.Unsubscribe(&sns.UnsubscribeInput{
+				SubscriptionArn: aws.String(d.Id()),
+			})
 
-		if err != nil {
-			return fmt.Errorf("Error unsubscribing from SNS topic: %s", err)
+			if err != nil {
+				return fmt.Errorf("Error unsubscribing from SNS topic: %s", err)
+			}
 		}
 
 		// Re-subscribe and set id
 		output, err := subscribeToSNSTopic(d, snsconn)
-		d.SetId(*output.SubscriptionArn)
-		d.Set("arn", *output.SubscriptionArn)
+		if err != nil {
+			return err
+		}
+		setSnsTopicSubscriptionId(d, output.SubscriptionArn)
+	}
+
+	// Attributes can only be set once the subscription has been confirmed
+	if isSnsTopicSubscriptionPendingId(d.Id()) {
+		log.Printf("[WARN] SNS Topic Subscription (%s) is pending confirmation, skipping attribute updates", d.Id())
+		return resourceAwsSnsTopicSubscriptionRead(d, meta)
 	}
 
 	if d.HasChange("raw_message_delivery") {
@@ -143,12 +155,53 @@ func resourceAwsSnsTopicSubscriptionUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
+	if d.HasChange("filter_policy") {
+		_, err := snsconn.SetSubscriptionAttributes(&sns.SetSubscriptionAttributesInput{
+			SubscriptionArn: aws.String(d.Id()),
+			AttributeName:   aws.String("FilterPolicy"),
+			AttributeValue:  aws.String(d.Get("filter_policy").(string)),
+		})
+
+		if err != nil {
+			return fmt.Errorf("Error setting filter policy on SNS Topic Subscription (%s): %s", d.Id(), err)
+		}
+	}
+
 	return resourceAwsSnsTopicSubscriptionRead(d, meta)
 }
 
 func resourceAwsSnsTopicSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
 	snsconn := meta.(*AWSClient).snsconn
 
+	if isSnsTopicSubscriptionPendingId(d.Id()) {
+		topicArn, protocol, endpoint, err := decodeSnsTopicSubscriptionPendingId(d.Id())
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[DEBUG] Looking up pending subscription %s", d.Id())
+		subscription, err := findSnsTopicSubscription(snsconn, topicArn, protocol, endpoint, true)
+		if err != nil {
+			return err
+		}
+		if subscription == nil {
+			log.Printf("[WARN] SNS Topic Subscription (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		d.Set("topic_arn", topicArn)
+		d.Set("protocol", protocol)
+		d.Set("endpoint", endpoint)
+
+		if subscriptionHasPendingConfirmation(subscription.SubscriptionArn) {
+			return nil
+		}
+
+		// The subscription has been confirmed since it was created
+		setSnsTopicSubscriptionId(d, subscription.SubscriptionArn)
+	}
+
 	log.Printf("[DEBUG] Loading subscription %s", d.Id())
 
 	attributeOutput, err := snsconn.GetSubscriptionAttributes(&sns.GetSubscriptionAttributesInput{
@@ -182,12 +235,27 @@ func resourceAwsSnsTopicSubscriptionRead(d *schema.ResourceData, meta interface{
 		}
 	}
 
+	// Attributes which have never been set are not returned at all, reset
+	// them so that values skipped while the subscription was pending are
+	// applied once it has been confirmed
+	if attributeOutput.Attributes["FilterPolicy"] == nil {
+		d.Set("filter_policy", "")
+	}
+	if attributeOutput.Attributes["RawMessageDelivery"] == nil {
+		d.Set("raw_message_delivery", false)
+	}
+
 	return nil
 }
 
 func resourceAwsSnsTopicSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
 	snsconn := meta.(*AWSClient).snsconn
 
+	if isSnsTopicSubscriptionPendingId(d.Id()) {
+		log.Printf("[WARN] SNS Topic Subscription (%s) is pending confirmation and cannot be deleted, it will expire after three days", d.Id())
+		return nil
+	}
+
 	log.Printf("[DEBUG] SNS delete topic subscription: %s", d.Id())
 	_, err := snsconn.Unsubscribe(&sns.UnsubscribeInput{
 		SubscriptionArn: aws.String(d.Id()),
@@ -230,7 +298,7 @@ func subscribeToSNSTopic(d *schema.ResourceData, snsconn *sns.SNS) (output *sns.
 
 		err = resource.Retry(time.Duration(confirmation_timeout_in_minutes)*time.Minute, func() *resource.RetryError {
 
-			subscription, err := findSubscriptionByNonID(d, snsconn)
+			subscription, err := findSnsTopicSubscription(snsconn, topic_arn, protocol, endpoint, false)
 
 			if subscription != nil {
 				output.SubscriptionArn = subscription.SubscriptionArn
@@ -255,38 +323,72 @@ func subscribeToSNSTopic(d *schema.ResourceData, snsconn *sns.SNS) (output *sns.
 	return output, nil
 }
 
-// finds a subscription using protocol, endpoint and topic_arn (which is a key in sns subscription)
-func findSubscriptionByNonID(d *schema.ResourceData, snsconn *sns.SNS) (*sns.Subscription, error) {
-	protocol := d.Get("protocol").(string)
-	endpoint := d.Get("endpoint").(string)
-	topic_arn := d.Get("topic_arn").(string)
+// finds a subscription using protocol, endpoint and topic_arn (which is a key in sns subscription),
+// only counting subscriptions that are still pending confirmation if includePending is set.
+// Returns nil if there is no matching subscription.
+func findSnsTopicSubscription(snsconn *sns.SNS, topicArn, protocol, endpoint string, includePending bool) (*sns.Subscription, error) {
 	obfuscatedEndpoint := obfuscateEndpoint(endpoint)
 
 	req := &sns.ListSubscriptionsByTopicInput{
-		TopicArn: aws.String(topic_arn),
+		TopicArn: aws.String(topicArn),
 	}
 
 	for {
 		res, err := snsconn.ListSubscriptionsByTopic(req)
-
 		if err != nil {
-			return nil, fmt.Errorf("Error fetching subscriptions for topic %s : %s", topic_arn, err)
+			if isAWSErr(err, sns.ErrCodeNotFoundException, "") {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("Error fetching subscriptions for topic %s : %s", topicArn, err)
 		}
 
 		for _, subscription := range res.Subscriptions {
-			log.Printf("[DEBUG] check subscription with Subscription EndPoint %s (local: %s), Protocol %s, topicARN %s and SubscriptionARN %s", *subscription.Endpoint, obfuscatedEndpoint, *subscription.Protocol, *subscription.TopicArn, *subscription.SubscriptionArn)
-			if *subscription.Endpoint == obfuscatedEndpoint && *subscription.Protocol == protocol && *subscription.TopicArn == topic_arn && !subscriptionHasPendingConfirmation(subscription.SubscriptionArn) {
-				return subscription, nil
+			log.Printf("[DEBUG] check subscription with Subscription EndPoint %s (local: %s), Protocol %s, topicARN %s and SubscriptionARN %s", aws.StringValue(subscription.Endpoint), obfuscatedEndpoint, aws.StringValue(subscription.Protocol), aws.StringValue(subscription.TopicArn), aws.StringValue(subscription.SubscriptionArn))
+			if aws.StringValue(subscription.Endpoint) != obfuscatedEndpoint || aws.StringValue(subscription.Protocol) != protocol {
+				continue
+			}
+			if !includePending && subscriptionHasPendingConfirmation(subscription.SubscriptionArn) {
+				continue
 			}
+			return subscription, nil
 		}
 
-		// if there are more than 100 subscriptions then go to the next 100 otherwise return an error
-		if res.NextToken != nil {
-			req.NextToken = res.NextToken
-		} else {
-			return nil, fmt.Errorf("Error finding subscription for topic %s with endpoint %s and protocol %s", topic_arn, endpoint, protocol)
+		// if there are more than 100 subscriptions then go to the next 100
+		if res.NextToken == nil {
+			return nil, nil
 		}
+		req.NextToken = res.NextToken
+	}
+}
+
+// sets the resource ID to the subscription ARN, or to a topic, protocol and
+// endpoint based ID while the subscription is still pending confirmation
+func setSnsTopicSubscriptionId(d *schema.ResourceData, arn *string) {
+	if subscriptionHasPendingConfirmation(arn) {
+		id := fmt.Sprintf("%s|%s|%s", d.Get("topic_arn").(string), d.Get("protocol").(string), d.Get("endpoint").(string))
+		log.Printf("[WARN] SNS Topic Subscription is pending confirmation, tracking it as %s", id)
+		d.SetId(id)
+		d.Set("arn", "")
+		return
+	}
+
+	log.Printf("New subscription ARN: %s", *arn)
+	d.SetId(*arn)
+
+	// Write the ARN to the 'arn' field for export
+	d.Set("arn", *arn)
+}
+
+func isSnsTopicSubscriptionPendingId(id string) bool {
+	return strings.Contains(id, "|")
+}
+
+func decodeSnsTopicSubscriptionPendingId(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, "|", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("Pending SNS Topic Subscription ID must be of the form <topic_arn>|<protocol>|<endpoint>, was provided: %s", id)
 	}
+	return parts[0], parts[1], parts[2], nil
 }
 
 // returns true if arn is nil or has both pending and confirmation words in the arn
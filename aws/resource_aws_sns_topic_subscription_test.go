@@ -69,6 +69,55 @@ func TestAccAWSSNSTopicSubscription_autoConfirmingSecuredEndpoint(t *testing.T)
 	})
 }
 
+func TestAccAWSSNSTopicSubscription_filterPolicy(t *testing.T) {
+	ri := acctest.RandInt()
+	filterPolicy1 := `{"key1": ["val1"], "key2": ["val2"]}`
+	filterPolicy2 := `{"key3": ["val3"], "key4": ["val4"]}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSNSTopicSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSNSTopicSubscriptionConfig_filterPolicy(ri, filterPolicy1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSNSTopicSubscriptionExists("aws_sns_topic_subscription.test_subscription"),
+					resource.TestCheckResourceAttr("aws_sns_topic_subscription.test_subscription", "filter_policy", `{"key1":["val1"],"key2":["val2"]}`),
+				),
+			},
+			{
+				Config: testAccAWSSNSTopicSubscriptionConfig_filterPolicy(ri, filterPolicy2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSNSTopicSubscriptionExists("aws_sns_topic_subscription.test_subscription"),
+					resource.TestCheckResourceAttr("aws_sns_topic_subscription.test_subscription", "filter_policy", `{"key3":["val3"],"key4":["val4"]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSNSTopicSubscription_email(t *testing.T) {
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSNSTopicSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSNSTopicSubscriptionConfig_email(ri),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSNSTopicExists("aws_sns_topic.test_topic"),
+					resource.TestCheckResourceAttr("aws_sns_topic_subscription.test_subscription", "protocol", "email"),
+					resource.TestCheckResourceAttr("aws_sns_topic_subscription.test_subscription", "endpoint", fmt.Sprintf("tf-acc-test-%d@example.com", ri)),
+					resource.TestCheckResourceAttr("aws_sns_topic_subscription.test_subscription", "arn", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSSNSTopicSubscriptionDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).snsconn
 
@@ -139,6 +188,41 @@ func TestObfuscateEndpointPassword(t *testing.T) {
 	}
 }
 
+func TestDecodeSnsTopicSubscriptionPendingId(t *testing.T) {
+	var testCases = []struct {
+		Input    string
+		Endpoint string
+		ErrCount int
+	}{
+		{
+			Input:    "arn:aws:sns:us-west-2:123456789012:my-topic|email|user@example.com",
+			Endpoint: "user@example.com",
+			ErrCount: 0,
+		},
+		{
+			Input:    "arn:aws:sns:us-west-2:123456789012:my-topic|email",
+			ErrCount: 1,
+		},
+		{
+			Input:    "arn:aws:sns:us-west-2:123456789012:my-topic||user@example.com",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		_, _, endpoint, err := decodeSnsTopicSubscriptionPendingId(tc.Input)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.Input, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.Input)
+		}
+		if endpoint != tc.Endpoint {
+			t.Fatalf("expected %q to decode endpoint %q, received %q", tc.Input, tc.Endpoint, endpoint)
+		}
+	}
+}
+
 func testAccAWSSNSTopicSubscriptionConfig(i int) string {
 	return fmt.Sprintf(`
 resource "aws_sns_topic" "test_topic" {
@@ -480,3 +564,36 @@ resource "aws_sns_topic_subscription" "test_subscription" {
 }
 `, i, i, i, i, i, i, i, i, i, username, password, username, password)
 }
+
+func testAccAWSSNSTopicSubscriptionConfig_filterPolicy(i int, policy string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test_topic" {
+    name = "terraform-test-topic-%d"
+}
+
+resource "aws_sqs_queue" "test_queue" {
+	name = "terraform-subscription-test-queue-%d"
+}
+
+resource "aws_sns_topic_subscription" "test_subscription" {
+    topic_arn = "${aws_sns_topic.test_topic.arn}"
+    protocol = "sqs"
+    endpoint = "${aws_sqs_queue.test_queue.arn}"
+    filter_policy = %q
+}
+`, i, i, policy)
+}
+
+func testAccAWSSNSTopicSubscriptionConfig_email(i int) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test_topic" {
+    name = "terraform-test-topic-%d"
+}
+
+resource "aws_sns_topic_subscription" "test_subscription" {
+    topic_arn = "${aws_sns_topic.test_topic.arn}"
+    protocol = "email"
+    endpoint = "tf-acc-test-%d@example.com"
+}
+`, i, i)
+}
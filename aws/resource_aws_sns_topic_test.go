@@ -107,6 +107,43 @@ func TestAccAWSSNSTopic_withDeliveryPolicy(t *testing.T) {
 	})
 }
 
+func TestAccAWSSNSTopic_deliveryStatus(t *testing.T) {
+	rName := acctest.RandString(10)
+	resourceName := "aws_sns_topic.test_topic"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: resourceName,
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckAWSSNSTopicDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSSNSTopicConfig_deliveryStatus(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSNSTopicExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "application_success_feedback_role_arn", "aws_iam_role.example", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "application_success_feedback_sample_rate", "100"),
+					resource.TestCheckResourceAttrPair(resourceName, "application_failure_feedback_role_arn", "aws_iam_role.example", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "lambda_success_feedback_role_arn", "aws_iam_role.example", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "lambda_success_feedback_sample_rate", "90"),
+					resource.TestCheckResourceAttrPair(resourceName, "lambda_failure_feedback_role_arn", "aws_iam_role.example", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "http_success_feedback_role_arn", "aws_iam_role.example", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "http_success_feedback_sample_rate", "80"),
+					resource.TestCheckResourceAttrPair(resourceName, "http_failure_feedback_role_arn", "aws_iam_role.example", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "sqs_success_feedback_role_arn", "aws_iam_role.example", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "sqs_success_feedback_sample_rate", "70"),
+					resource.TestCheckResourceAttrPair(resourceName, "sqs_failure_feedback_role_arn", "aws_iam_role.example", "arn"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckAWSNSTopicHasPolicy(n string, expectedPolicyText string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -379,3 +416,70 @@ EOF
 }
 `, r)
 }
+
+func testAccAWSSNSTopicConfig_deliveryStatus(r string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "example" {
+  name = "sns-delivery-status-role-%s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "sns.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "example" {
+  name = "sns-delivery-status-role-policy-%s"
+  role = "${aws_iam_role.example.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "logs:CreateLogGroup",
+        "logs:CreateLogStream",
+        "logs:PutLogEvents",
+        "logs:PutMetricFilter",
+        "logs:PutRetentionPolicy"
+      ],
+      "Resource": [
+        "*"
+      ]
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_sns_topic" "test_topic" {
+  depends_on = ["aws_iam_role_policy.example"]
+
+  name                                     = "sns-delivery-status-topic-%s"
+  application_success_feedback_role_arn    = "${aws_iam_role.example.arn}"
+  application_success_feedback_sample_rate = 100
+  application_failure_feedback_role_arn    = "${aws_iam_role.example.arn}"
+  lambda_success_feedback_role_arn         = "${aws_iam_role.example.arn}"
+  lambda_success_feedback_sample_rate      = 90
+  lambda_failure_feedback_role_arn         = "${aws_iam_role.example.arn}"
+  http_success_feedback_role_arn           = "${aws_iam_role.example.arn}"
+  http_success_feedback_sample_rate        = 80
+  http_failure_feedback_role_arn           = "${aws_iam_role.example.arn}"
+  sqs_success_feedback_role_arn            = "${aws_iam_role.example.arn}"
+  sqs_success_feedback_sample_rate         = 70
+  sqs_failure_feedback_role_arn            = "${aws_iam_role.example.arn}"
+}
+`, r, r, r)
+}
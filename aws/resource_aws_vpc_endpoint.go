@@ -3,12 +3,15 @@ package aws
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsVpcEndpoint() *schema.Resource {
@@ -42,6 +45,65 @@ func resourceAwsVpcEndpoint() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"vpc_endpoint_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  ec2.VpcEndpointTypeGateway,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.VpcEndpointTypeGateway,
+					ec2.VpcEndpointTypeInterface,
+				}, false),
+			},
+			"auto_accept": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"subnet_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"security_group_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"private_dns_enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"network_interface_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"dns_entry": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dns_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hosted_zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"state": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"route_table_ids": &schema.Schema{
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -59,14 +121,30 @@ func resourceAwsVpcEndpoint() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 	}
 }
 
 func resourceAwsVPCEndpointCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	input := &ec2.CreateVpcEndpointInput{
-		VpcId:       aws.String(d.Get("vpc_id").(string)),
-		ServiceName: aws.String(d.Get("service_name").(string)),
+		VpcId:             aws.String(d.Get("vpc_id").(string)),
+		ServiceName:       aws.String(d.Get("service_name").(string)),
+		VpcEndpointType:   aws.String(d.Get("vpc_endpoint_type").(string)),
+		PrivateDnsEnabled: aws.Bool(d.Get("private_dns_enabled").(bool)),
+	}
+
+	if v, ok := d.GetOk("subnet_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SubnetIds = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SecurityGroupIds = expandStringSet(v.(*schema.Set))
 	}
 
 	if v, ok := d.GetOk("route_table_ids"); ok {
@@ -93,6 +171,16 @@ func resourceAwsVPCEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 
 	d.SetId(*output.VpcEndpoint.VpcEndpointId)
 
+	if d.Get("auto_accept").(bool) && aws.StringValue(output.VpcEndpoint.State) == "pendingAcceptance" {
+		if err := vpcEndpointAccept(conn, d.Id(), aws.StringValue(output.VpcEndpoint.ServiceName)); err != nil {
+			return err
+		}
+	}
+
+	if err := vpcEndpointWaitUntilAvailable(d, conn, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
 	return resourceAwsVPCEndpointRead(d, meta)
 }
 
@@ -127,6 +215,47 @@ func resourceAwsVPCEndpointRead(d *schema.ResourceData, meta interface{}) error
 
 	vpce := output.VpcEndpoints[0]
 
+	policy := ""
+	if vpce.PolicyDocument != nil {
+		policy, err = normalizeJsonString(*vpce.PolicyDocument)
+		if err != nil {
+			return errwrap.Wrapf("policy contains an invalid JSON: {{err}}", err)
+		}
+	}
+
+	d.Set("vpc_id", vpce.VpcId)
+	d.Set("policy", policy)
+	d.Set("service_name", vpce.ServiceName)
+	d.Set("vpc_endpoint_type", vpce.VpcEndpointType)
+	d.Set("private_dns_enabled", vpce.PrivateDnsEnabled)
+	d.Set("state", vpce.State)
+	if err := d.Set("route_table_ids", aws.StringValueSlice(vpce.RouteTableIds)); err != nil {
+		return err
+	}
+	if err := d.Set("subnet_ids", aws.StringValueSlice(vpce.SubnetIds)); err != nil {
+		return err
+	}
+	if err := d.Set("network_interface_ids", aws.StringValueSlice(vpce.NetworkInterfaceIds)); err != nil {
+		return err
+	}
+	sgIds := make([]string, 0, len(vpce.Groups))
+	for _, group := range vpce.Groups {
+		sgIds = append(sgIds, aws.StringValue(group.GroupId))
+	}
+	if err := d.Set("security_group_ids", sgIds); err != nil {
+		return err
+	}
+	if err := d.Set("dns_entry", flattenVpcEndpointDnsEntries(vpce.DnsEntries)); err != nil {
+		return err
+	}
+
+	// Only Gateway endpoints are associated with a prefix list.
+	if aws.StringValue(vpce.VpcEndpointType) == ec2.VpcEndpointTypeInterface {
+		d.Set("prefix_list_id", "")
+		d.Set("cidr_blocks", []string{})
+		return nil
+	}
+
 	// A VPC Endpoint is associated with exactly one prefix list name (also called Service Name).
 	// The prefix list ID can be used in security groups, so retrieve it to support that capability.
 	prefixListServiceName := *vpce.ServiceName
@@ -150,17 +279,6 @@ func resourceAwsVPCEndpointRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("There are multiple prefix lists associated with the service name '%s'. Unexpected", prefixListServiceName)
 	}
 
-	policy, err := normalizeJsonString(*vpce.PolicyDocument)
-	if err != nil {
-		return errwrap.Wrapf("policy contains an invalid JSON: {{err}}", err)
-	}
-
-	d.Set("vpc_id", vpce.VpcId)
-	d.Set("policy", policy)
-	d.Set("service_name", vpce.ServiceName)
-	if err := d.Set("route_table_ids", aws.StringValueSlice(vpce.RouteTableIds)); err != nil {
-		return err
-	}
 	pl := prefixListsOutput.PrefixLists[0]
 	d.Set("prefix_list_id", pl.PrefixListId)
 	d.Set("cidr_blocks", aws.StringValueSlice(pl.Cidrs))
@@ -190,6 +308,36 @@ func resourceAwsVPCEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if d.HasChange("subnet_ids") {
+		o, n := d.GetChange("subnet_ids")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		if add := expandStringSet(ns.Difference(os)); len(add) > 0 {
+			input.AddSubnetIds = add
+		}
+		if remove := expandStringSet(os.Difference(ns)); len(remove) > 0 {
+			input.RemoveSubnetIds = remove
+		}
+	}
+
+	if d.HasChange("security_group_ids") {
+		o, n := d.GetChange("security_group_ids")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		if add := expandStringSet(ns.Difference(os)); len(add) > 0 {
+			input.AddSecurityGroupIds = add
+		}
+		if remove := expandStringSet(os.Difference(ns)); len(remove) > 0 {
+			input.RemoveSecurityGroupIds = remove
+		}
+	}
+
+	if d.HasChange("private_dns_enabled") {
+		input.PrivateDnsEnabled = aws.Bool(d.Get("private_dns_enabled").(bool))
+	}
+
 	if d.HasChange("policy") {
 		policy, err := normalizeJsonString(d.Get("policy"))
 		if err != nil {
@@ -205,6 +353,16 @@ func resourceAwsVPCEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 	}
 	log.Printf("[DEBUG] VPC Endpoint %q updated", input.VpcEndpointId)
 
+	if d.HasChange("auto_accept") && d.Get("auto_accept").(bool) && d.Get("state").(string) == "pendingAcceptance" {
+		if err := vpcEndpointAccept(conn, d.Id(), d.Get("service_name").(string)); err != nil {
+			return err
+		}
+	}
+
+	if err := vpcEndpointWaitUntilAvailable(d, conn, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
 	return resourceAwsVPCEndpointRead(d, meta)
 }
 
@@ -230,8 +388,112 @@ func resourceAwsVPCEndpointDelete(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"available", "pending", "deleting"},
+		Target:     []string{"deleted"},
+		Refresh:    vpcEndpointStateRefresh(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err = stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for VPC Endpoint %s to delete: %s", d.Id(), err.Error())
+	}
+
 	log.Printf("[DEBUG] VPC Endpoint %q deleted", d.Id())
 	d.SetId("")
 
 	return nil
 }
+
+func vpcEndpointAccept(conn *ec2.EC2, vpceId, svcName string) error {
+	describeSvcReq := &ec2.DescribeVpcEndpointServiceConfigurationsInput{}
+	describeSvcReq.Filters = buildEC2AttributeFilterList(
+		map[string]string{
+			"service-name": svcName,
+		},
+	)
+
+	describeSvcResp, err := conn.DescribeVpcEndpointServiceConfigurations(describeSvcReq)
+	if err != nil {
+		return fmt.Errorf("Error reading VPC Endpoint Service: %s", err.Error())
+	}
+	if describeSvcResp == nil || len(describeSvcResp.ServiceConfigurations) == 0 {
+		return fmt.Errorf("No matching VPC Endpoint Service found")
+	}
+
+	acceptEpReq := &ec2.AcceptVpcEndpointConnectionsInput{
+		ServiceId:      describeSvcResp.ServiceConfigurations[0].ServiceId,
+		VpcEndpointIds: []*string{aws.String(vpceId)},
+	}
+
+	log.Printf("[DEBUG] Accepting VPC Endpoint connection: %#v", acceptEpReq)
+	_, err = conn.AcceptVpcEndpointConnections(acceptEpReq)
+	if err != nil {
+		return fmt.Errorf("Error accepting VPC Endpoint connection: %s", err.Error())
+	}
+
+	return nil
+}
+
+func vpcEndpointStateRefresh(conn *ec2.EC2, vpceId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		log.Printf("[DEBUG] Reading VPC Endpoint: %s", vpceId)
+		resp, err := conn.DescribeVpcEndpoints(&ec2.DescribeVpcEndpointsInput{
+			VpcEndpointIds: aws.StringSlice([]string{vpceId}),
+		})
+		if err != nil {
+			if isAWSErr(err, "InvalidVpcEndpointId.NotFound", "") {
+				return false, "deleted", nil
+			}
+
+			return nil, "", err
+		}
+
+		if len(resp.VpcEndpoints) == 0 {
+			return false, "deleted", nil
+		}
+
+		vpce := resp.VpcEndpoints[0]
+		state := aws.StringValue(vpce.State)
+		// No use in retrying if the endpoint is in a failed state.
+		if state == "failed" {
+			return nil, state, fmt.Errorf("VPC Endpoint %s is in failed state", vpceId)
+		}
+		return vpce, state, nil
+	}
+}
+
+func vpcEndpointWaitUntilAvailable(d *schema.ResourceData, conn *ec2.EC2, timeout time.Duration) error {
+	target := []string{"available"}
+	if !d.Get("auto_accept").(bool) {
+		target = append(target, "pendingAcceptance")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"pending"},
+		Target:     target,
+		Refresh:    vpcEndpointStateRefresh(conn, d.Id()),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for VPC Endpoint %s to become available: %s", d.Id(), err.Error())
+	}
+
+	return nil
+}
+
+func flattenVpcEndpointDnsEntries(dnsEntries []*ec2.DnsEntry) []interface{} {
+	vDnsEntries := []interface{}{}
+
+	for _, dnsEntry := range dnsEntries {
+		vDnsEntries = append(vDnsEntries, map[string]interface{}{
+			"dns_name":       aws.StringValue(dnsEntry.DnsName),
+			"hosted_zone_id": aws.StringValue(dnsEntry.HostedZoneId),
+		})
+	}
+
+	return vDnsEntries
+}
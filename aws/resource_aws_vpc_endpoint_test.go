@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 
+	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -82,6 +83,87 @@ func TestAccAWSVpcEndpoint_WithoutRouteTableOrPolicyConfig(t *testing.T) {
 	})
 }
 
+func TestAccAWSVpcEndpoint_interfaceBasic(t *testing.T) {
+	var endpoint ec2.VpcEndpoint
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_vpc_endpoint.ec2",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckVpcEndpointDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpcEndpointConfig_interfaceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists("aws_vpc_endpoint.ec2", &endpoint),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "vpc_endpoint_type", "Interface"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "subnet_ids.#", "0"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "security_group_ids.#", "1"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "private_dns_enabled", "false"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "state", "available"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcEndpoint_interfaceWithSubnetAndSecurityGroup(t *testing.T) {
+	var endpoint ec2.VpcEndpoint
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_vpc_endpoint.ec2",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckVpcEndpointDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpcEndpointConfig_interfaceWithSubnet,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists("aws_vpc_endpoint.ec2", &endpoint),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "vpc_endpoint_type", "Interface"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "subnet_ids.#", "1"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "security_group_ids.#", "1"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "network_interface_ids.#", "1"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "private_dns_enabled", "false"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccVpcEndpointConfig_interfaceWithSubnetModified,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists("aws_vpc_endpoint.ec2", &endpoint),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "subnet_ids.#", "3"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "security_group_ids.#", "2"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "network_interface_ids.#", "3"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "private_dns_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcEndpoint_interfaceNonAWSService(t *testing.T) {
+	lbName := fmt.Sprintf("testaccawsnlb-basic-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	var endpoint ec2.VpcEndpoint
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_vpc_endpoint.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckVpcEndpointDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpcEndpointConfig_interfaceNonAWSService(lbName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists("aws_vpc_endpoint.foo", &endpoint),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.foo", "vpc_endpoint_type", "Interface"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.foo", "auto_accept", "true"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.foo", "state", "available"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSVpcEndpoint_removed(t *testing.T) {
 	var endpoint ec2.VpcEndpoint
 
@@ -136,7 +218,7 @@ func testAccCheckVpcEndpointDestroy(s *terraform.State) error {
 			}
 			return err
 		}
-		if len(resp.VpcEndpoints) > 0 {
+		if len(resp.VpcEndpoints) > 0 && aws.StringValue(resp.VpcEndpoints[0].State) != "deleted" {
 			return fmt.Errorf("VPC Endpoints still exist.")
 		}
 
@@ -305,3 +387,197 @@ resource "aws_vpc_endpoint" "second-private-s3" {
     service_name = "com.amazonaws.us-west-2.s3"
 }
 `
+
+const testAccVpcEndpointConfig_interfaceBasic = `
+resource "aws_vpc" "foo" {
+  cidr_block = "10.0.0.0/16"
+  tags {
+    Name = "terraform-testacc-vpc-endpoint-interface"
+  }
+}
+
+data "aws_security_group" "default" {
+  vpc_id = "${aws_vpc.foo.id}"
+  name = "default"
+}
+
+data "aws_vpc_endpoint_service" "ec2" {
+  service = "ec2"
+}
+
+resource "aws_vpc_endpoint" "ec2" {
+  vpc_id = "${aws_vpc.foo.id}"
+  vpc_endpoint_type = "Interface"
+  service_name = "${data.aws_vpc_endpoint_service.ec2.service_name}"
+  security_group_ids = ["${data.aws_security_group.default.id}"]
+}
+`
+
+const testAccVpcEndpointConfig_interfaceWithSubnet = `
+resource "aws_vpc" "foo" {
+  cidr_block = "10.0.0.0/16"
+  enable_dns_support = true
+  enable_dns_hostnames = true
+  tags {
+    Name = "terraform-testacc-vpc-endpoint-interface-w-subnet"
+  }
+}
+
+data "aws_availability_zones" "available" {}
+
+resource "aws_subnet" "sn1" {
+  vpc_id = "${aws_vpc.foo.id}"
+  cidr_block = "10.0.0.0/17"
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+}
+
+resource "aws_subnet" "sn2" {
+  vpc_id = "${aws_vpc.foo.id}"
+  cidr_block = "10.0.128.0/18"
+  availability_zone = "${data.aws_availability_zones.available.names[1]}"
+}
+
+resource "aws_subnet" "sn3" {
+  vpc_id = "${aws_vpc.foo.id}"
+  cidr_block = "10.0.192.0/18"
+  availability_zone = "${data.aws_availability_zones.available.names[2]}"
+}
+
+resource "aws_security_group" "sg1" {
+  vpc_id = "${aws_vpc.foo.id}"
+}
+
+resource "aws_security_group" "sg2" {
+  vpc_id = "${aws_vpc.foo.id}"
+}
+
+data "aws_vpc_endpoint_service" "ec2" {
+  service = "ec2"
+}
+
+resource "aws_vpc_endpoint" "ec2" {
+  vpc_id = "${aws_vpc.foo.id}"
+  vpc_endpoint_type = "Interface"
+  service_name = "${data.aws_vpc_endpoint_service.ec2.service_name}"
+  subnet_ids = ["${aws_subnet.sn1.id}"]
+  security_group_ids = ["${aws_security_group.sg1.id}"]
+  private_dns_enabled = false
+}
+`
+
+const testAccVpcEndpointConfig_interfaceWithSubnetModified = `
+resource "aws_vpc" "foo" {
+  cidr_block = "10.0.0.0/16"
+  enable_dns_support = true
+  enable_dns_hostnames = true
+  tags {
+    Name = "terraform-testacc-vpc-endpoint-interface-w-subnet"
+  }
+}
+
+data "aws_availability_zones" "available" {}
+
+resource "aws_subnet" "sn1" {
+  vpc_id = "${aws_vpc.foo.id}"
+  cidr_block = "10.0.0.0/17"
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+}
+
+resource "aws_subnet" "sn2" {
+  vpc_id = "${aws_vpc.foo.id}"
+  cidr_block = "10.0.128.0/18"
+  availability_zone = "${data.aws_availability_zones.available.names[1]}"
+}
+
+resource "aws_subnet" "sn3" {
+  vpc_id = "${aws_vpc.foo.id}"
+  cidr_block = "10.0.192.0/18"
+  availability_zone = "${data.aws_availability_zones.available.names[2]}"
+}
+
+resource "aws_security_group" "sg1" {
+  vpc_id = "${aws_vpc.foo.id}"
+}
+
+resource "aws_security_group" "sg2" {
+  vpc_id = "${aws_vpc.foo.id}"
+}
+
+data "aws_vpc_endpoint_service" "ec2" {
+  service = "ec2"
+}
+
+resource "aws_vpc_endpoint" "ec2" {
+  vpc_id = "${aws_vpc.foo.id}"
+  vpc_endpoint_type = "Interface"
+  service_name = "${data.aws_vpc_endpoint_service.ec2.service_name}"
+  subnet_ids = ["${aws_subnet.sn1.id}", "${aws_subnet.sn2.id}", "${aws_subnet.sn3.id}"]
+  security_group_ids = ["${aws_security_group.sg1.id}", "${aws_security_group.sg2.id}"]
+  private_dns_enabled = true
+}
+`
+
+func testAccVpcEndpointConfig_interfaceNonAWSService(lbName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "nlb_test" {
+  cidr_block = "10.0.0.0/16"
+  tags {
+    Name = "terraform-testacc-vpc-endpoint-non-aws-service"
+  }
+}
+
+resource "aws_lb" "nlb_test" {
+  name = "%s"
+
+  subnets = [
+    "${aws_subnet.nlb_test_1.id}",
+    "${aws_subnet.nlb_test_2.id}",
+  ]
+
+  load_balancer_type = "network"
+  internal = true
+  idle_timeout = 60
+  enable_deletion_protection = false
+}
+
+data "aws_availability_zones" "available" {}
+
+resource "aws_subnet" "nlb_test_1" {
+  vpc_id = "${aws_vpc.nlb_test.id}"
+  cidr_block = "10.0.1.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+}
+
+resource "aws_subnet" "nlb_test_2" {
+  vpc_id = "${aws_vpc.nlb_test.id}"
+  cidr_block = "10.0.2.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[1]}"
+}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_vpc_endpoint_service" "foo" {
+  acceptance_required = true
+
+  network_load_balancer_arns = [
+    "${aws_lb.nlb_test.id}",
+  ]
+
+  allowed_principals = [
+    "${data.aws_caller_identity.current.arn}"
+  ]
+}
+
+resource "aws_security_group" "sg1" {
+  vpc_id = "${aws_vpc.nlb_test.id}"
+}
+
+resource "aws_vpc_endpoint" "foo" {
+  vpc_id = "${aws_vpc.nlb_test.id}"
+  service_name = "${aws_vpc_endpoint_service.foo.service_name}"
+  vpc_endpoint_type = "Interface"
+  security_group_ids = ["${aws_security_group.sg1.id}"]
+  auto_accept = true
+}
+`, lbName)
+}
@@ -52,6 +52,41 @@ func setTagsS3(conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
+// setTagsS3Object is a helper to set the tags for an S3 object. It expects the
+// tags field to be named "tags". Object tag sets are always replaced in full.
+func setTagsS3Object(conn *s3.S3, d *schema.ResourceData) error {
+	if d.HasChange("tags") {
+		bucket := d.Get("bucket").(string)
+		key := d.Get("key").(string)
+		tags := tagsFromMapS3(d.Get("tags").(map[string]interface{}))
+
+		if len(tags) == 0 {
+			log.Printf("[DEBUG] Removing all tags from S3 object (bucket: %s, key: %s)", bucket, key)
+			_, err := conn.DeleteObjectTagging(&s3.DeleteObjectTaggingInput{
+				Bucket: aws.String(bucket),
+				Key:    aws.String(key),
+			})
+			if err != nil {
+				return err
+			}
+		} else {
+			log.Printf("[DEBUG] Setting tags on S3 object (bucket: %s, key: %s): %#v", bucket, key, tags)
+			_, err := conn.PutObjectTagging(&s3.PutObjectTaggingInput{
+				Bucket: aws.String(bucket),
+				Key:    aws.String(key),
+				Tagging: &s3.Tagging{
+					TagSet: tags,
+				},
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
@@ -821,6 +821,23 @@ func flattenEc2NetworkInterfaceAssociation(a *ec2.NetworkInterfaceAssociation) [
 	return []interface{}{att}
 }
 
+func flattenEc2NetworkInterfaceAttachment(a *ec2.NetworkInterfaceAttachment) []interface{} {
+	att := make(map[string]interface{})
+	if a.AttachmentId != nil {
+		att["attachment_id"] = *a.AttachmentId
+	}
+	if a.DeviceIndex != nil {
+		att["device_index"] = *a.DeviceIndex
+	}
+	if a.InstanceId != nil {
+		att["instance_id"] = *a.InstanceId
+	}
+	if a.InstanceOwnerId != nil {
+		att["instance_owner_id"] = *a.InstanceOwnerId
+	}
+	return []interface{}{att}
+}
+
 func flattenEc2NetworkInterfaceIpv6Address(niia []*ec2.NetworkInterfaceIpv6Address) []string {
 	ips := make([]string, 0, len(niia))
 	for _, v := range niia {
@@ -1213,6 +1230,47 @@ func flattenDSConnectSettings(
 	return []map[string]interface{}{settings}
 }
 
+func expandDSRadiusSettings(l []interface{}) *directoryservice.RadiusSettings {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	settings := &directoryservice.RadiusSettings{
+		AuthenticationProtocol: aws.String(m["authentication_protocol"].(string)),
+		RadiusPort:             aws.Int64(int64(m["radius_port"].(int))),
+		RadiusRetries:          aws.Int64(int64(m["radius_retries"].(int))),
+		RadiusServers:          expandStringList(m["radius_servers"].(*schema.Set).List()),
+		RadiusTimeout:          aws.Int64(int64(m["radius_timeout"].(int))),
+		SharedSecret:           aws.String(m["shared_secret"].(string)),
+		UseSameUsername:        aws.Bool(m["use_same_username"].(bool)),
+	}
+	if v, ok := m["display_label"].(string); ok && v != "" {
+		settings.DisplayLabel = aws.String(v)
+	}
+
+	return settings
+}
+
+func flattenDSRadiusSettings(s *directoryservice.RadiusSettings, sharedSecret string) []map[string]interface{} {
+	if s == nil || len(s.RadiusServers) == 0 {
+		return nil
+	}
+
+	settings := map[string]interface{}{
+		"authentication_protocol": aws.StringValue(s.AuthenticationProtocol),
+		"display_label":           aws.StringValue(s.DisplayLabel),
+		"radius_port":             int(aws.Int64Value(s.RadiusPort)),
+		"radius_retries":          int(aws.Int64Value(s.RadiusRetries)),
+		"radius_servers":          schema.NewSet(schema.HashString, flattenStringList(s.RadiusServers)),
+		"radius_timeout":          int(aws.Int64Value(s.RadiusTimeout)),
+		"shared_secret":           sharedSecret,
+		"use_same_username":       aws.BoolValue(s.UseSameUsername),
+	}
+
+	return []map[string]interface{}{settings}
+}
+
 func expandCloudFormationParameters(params map[string]interface{}) []*cloudformation.Parameter {
 	var cfParams []*cloudformation.Parameter
 	for k, v := range params {
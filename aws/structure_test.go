@@ -802,6 +802,33 @@ func TestFlattenAttachmentWhenNoInstanceId(t *testing.T) {
 	}
 }
 
+func TestFlattenEc2NetworkInterfaceAttachment(t *testing.T) {
+	expanded := &ec2.NetworkInterfaceAttachment{
+		InstanceId:      aws.String("i-00001"),
+		InstanceOwnerId: aws.String("123456789012"),
+		DeviceIndex:     aws.Int64(int64(1)),
+		AttachmentId:    aws.String("at-002"),
+	}
+
+	result := flattenEc2NetworkInterfaceAttachment(expanded)[0].(map[string]interface{})
+
+	if result["instance_id"] != "i-00001" {
+		t.Fatalf("expected instance_id to be i-00001, but got %s", result["instance_id"])
+	}
+
+	if result["instance_owner_id"] != "123456789012" {
+		t.Fatalf("expected instance_owner_id to be 123456789012, but got %s", result["instance_owner_id"])
+	}
+
+	if result["device_index"] != int64(1) {
+		t.Fatalf("expected device_index to be 1, but got %d", result["device_index"])
+	}
+
+	if result["attachment_id"] != "at-002" {
+		t.Fatalf("expected attachment_id to be at-002, but got %s", result["attachment_id"])
+	}
+}
+
 func TestFlattenStepAdjustments(t *testing.T) {
 	expanded := []*autoscaling.StepAdjustment{
 		&autoscaling.StepAdjustment{
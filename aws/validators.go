@@ -5,6 +5,7 @@ import (
 	"net"
 	"net/url"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -793,15 +794,22 @@ func validateSQSFifoQueueName(v interface{}, k string) (errors []error) {
 }
 
 func validateSNSSubscriptionProtocol(v interface{}, k string) (ws []string, errors []error) {
-	value := strings.ToLower(v.(string))
-	forbidden := []string{"email"}
-	for _, f := range forbidden {
-		if strings.Contains(value, f) {
-			errors = append(
-				errors,
-				fmt.Errorf("Unsupported protocol (%s) for SNS Topic", value),
-			)
-		}
+	value := v.(string)
+	validProtocols := map[string]bool{
+		"application": true,
+		"email":       true,
+		"email-json":  true,
+		"http":        true,
+		"https":       true,
+		"lambda":      true,
+		"sms":         true,
+		"sqs":         true,
+	}
+	if !validProtocols[value] {
+		errors = append(
+			errors,
+			fmt.Errorf("Unsupported protocol (%s) for SNS Topic", value),
+		)
 	}
 	return
 }
@@ -1395,6 +1403,39 @@ func validateAwsKmsName(v interface{}, k string) (ws []string, es []error) {
 	return
 }
 
+func validateAwsKmsGrantName(v interface{}, k string) (ws []string, es []error) {
+	value := v.(string)
+
+	if len(value) > 256 {
+		es = append(es, fmt.Errorf("%s can not be greater than 256 characters", k))
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9:/_-]+$`).MatchString(value) {
+		es = append(es, fmt.Errorf("%s must only contain [a-zA-Z0-9:/_-]", k))
+	}
+
+	return
+}
+
+func validateRFC3339TimeString(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := time.Parse(time.RFC3339, v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: invalid RFC3339 timestamp", k))
+	}
+	return
+}
+
+func validateAwsDynamoDbTableName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if (len(value) > 255) || (len(value) < 3) {
+		errors = append(errors, fmt.Errorf("%s length must be between 3 and 255 characters: %q", k, value))
+	}
+	pattern := `^[a-zA-Z0-9_.-]+$`
+	if !regexp.MustCompile(pattern).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%s must only include alphanumeric, underscore, period, or hyphen characters: %q", k, value))
+	}
+	return
+}
+
 func validateCognitoIdentityPoolName(v interface{}, k string) (ws []string, errors []error) {
 	val := v.(string)
 	if !regexp.MustCompile("^[\\w _]+$").MatchString(val) {
@@ -2049,3 +2090,63 @@ func validateServiceDiscoveryServiceHealthCheckConfigType(v interface{}, k strin
 	errors = append(errors, fmt.Errorf("expected %s to be one of %v, got %s", k, validType, value))
 	return
 }
+
+func validateIoTTopicRuleName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 1 || len(value) > 128 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 128 characters long", k))
+	}
+	if !regexp.MustCompile(`^[a-zA-Z0-9_]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"only alphanumeric characters and underscores allowed in %q", k))
+	}
+	return
+}
+
+func validateIoTTopicRuleCloudWatchAlarmStateValue(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validStates := []string{"OK", "ALARM", "INSUFFICIENT_DATA"}
+	for _, str := range validStates {
+		if value == str {
+			return
+		}
+	}
+	errors = append(errors, fmt.Errorf("expected %s to be one of %v, got %s", k, validStates, value))
+	return
+}
+
+func validateIoTTopicRuleElasticSearchEndpoint(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^https?://.+`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be an http or https URL, got %q", k, value))
+	}
+	return
+}
+
+func validateIoTTopicRuleFirehoseSeparator(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validSeparators := []string{",", "\t", "\n", "\r\n"}
+	for _, str := range validSeparators {
+		if value == str {
+			return
+		}
+	}
+	errors = append(errors, fmt.Errorf("expected %s to be one of %q, got %q", k, validSeparators, value))
+	return
+}
+
+func validateAmazonSideAsn(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_CreateVpnGateway.html
+	asn, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q (%q) must be a 64-bit integer", k, v))
+		return
+	}
+
+	if (asn < 64512) || (asn > 65534 && asn < 4200000000) || (asn > 4294967294) {
+		errors = append(errors, fmt.Errorf("%q (%q) must be in the range 64512 to 65534 or 4200000000 to 4294967294", k, v))
+	}
+	return
+}
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -1130,6 +1131,8 @@ func TestValidateSNSSubscriptionProtocol(t *testing.T) {
 		"http",
 		"https",
 		"sms",
+		"email",
+		"email-json",
 	}
 	for _, v := range validProtocols {
 		if _, errors := validateSNSSubscriptionProtocol(v, "protocol"); len(errors) > 0 {
@@ -1139,9 +1142,11 @@ func TestValidateSNSSubscriptionProtocol(t *testing.T) {
 
 	invalidProtocols := []string{
 		"Email",
-		"email",
 		"Email-JSON",
-		"email-json",
+		"SQS",
+		"Lambda",
+		"smtp",
+		"email-html",
 	}
 	for _, v := range invalidProtocols {
 		if _, errors := validateSNSSubscriptionProtocol(v, "protocol"); len(errors) == 0 {
@@ -2179,6 +2184,120 @@ func TestValidateAwsKmsName(t *testing.T) {
 	}
 }
 
+func TestValidateAwsKmsGrantName(t *testing.T) {
+	validValues := []string{
+		"123",
+		"Abc",
+		"grant_1",
+		"grant:/-",
+	}
+
+	for _, s := range validValues {
+		_, errors := validateAwsKmsGrantName(s, "name")
+		if len(errors) > 0 {
+			t.Fatalf("%q AWS KMS Grant Name should have been valid: %v", s, errors)
+		}
+	}
+
+	invalidValues := []string{
+		strings.Repeat("w", 257),
+		"grant.invalid",
+		";",
+		"white space",
+	}
+
+	for _, s := range invalidValues {
+		_, errors := validateAwsKmsGrantName(s, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should not be a valid AWS KMS Grant Name", s)
+		}
+	}
+}
+
+func TestValidateAwsDynamoDbTableName(t *testing.T) {
+	validValues := []string{
+		"abc",
+		"tf-acc-test_table.1",
+		strings.Repeat("w", 255),
+	}
+
+	for _, s := range validValues {
+		_, errors := validateAwsDynamoDbTableName(s, "name")
+		if len(errors) > 0 {
+			t.Fatalf("%q DynamoDB table name should have been valid: %v", s, errors)
+		}
+	}
+
+	invalidValues := []string{
+		"ab",
+		strings.Repeat("w", 256),
+		"table name",
+		"table:name",
+	}
+
+	for _, s := range invalidValues {
+		_, errors := validateAwsDynamoDbTableName(s, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should not be a valid DynamoDB table name", s)
+		}
+	}
+}
+
+func TestValidateRFC3339TimeString(t *testing.T) {
+	testCases := []struct {
+		val         interface{}
+		expectedErr *regexp.Regexp
+	}{
+		{
+			val: "2018-03-01T00:00:00Z",
+		},
+		{
+			val: "2018-03-01T00:00:00-05:00",
+		},
+		{
+			val: "2018-03-01T00:00:00+05:00",
+		},
+		{
+			val:         "03/01/2018",
+			expectedErr: regexp.MustCompile(`invalid RFC3339 timestamp`),
+		},
+		{
+			val:         "03-01-2018",
+			expectedErr: regexp.MustCompile(`invalid RFC3339 timestamp`),
+		},
+		{
+			val:         "2018-03-01",
+			expectedErr: regexp.MustCompile(`invalid RFC3339 timestamp`),
+		},
+		{
+			val:         "2018-03-01T",
+			expectedErr: regexp.MustCompile(`invalid RFC3339 timestamp`),
+		},
+		{
+			val:         "2018-03-01T00:00:00",
+			expectedErr: regexp.MustCompile(`invalid RFC3339 timestamp`),
+		},
+	}
+
+	for i, tc := range testCases {
+		_, errs := validateRFC3339TimeString(tc.val, "test_property")
+
+		if tc.expectedErr == nil {
+			if len(errs) != 0 {
+				t.Fatalf("%d: expected no errors, got: %v", i, errs)
+			}
+			continue
+		}
+
+		if len(errs) == 0 {
+			t.Fatalf("%d: expected error matching %q, got none", i, tc.expectedErr)
+		}
+		if !tc.expectedErr.MatchString(errs[0].Error()) {
+			t.Fatalf("%d: expected error matching %q, got: %s", i, tc.expectedErr, errs[0])
+		}
+	}
+}
+
 func TestValidateCognitoIdentityPoolName(t *testing.T) {
 	validValues := []string{
 		"123",
@@ -2940,3 +3059,143 @@ func TestValidateServiceDiscoveryServiceHealthCheckConfigType(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateIoTTopicRuleName(t *testing.T) {
+	validNames := []string{
+		"foo",
+		"foo_bar",
+		"Foo_Bar_123",
+	}
+	for _, v := range validNames {
+		_, errors := validateIoTTopicRuleName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid IoT Topic Rule name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"foo-bar",
+		"foo bar",
+		strings.Repeat("W", 129),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateIoTTopicRuleName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid IoT Topic Rule name", v)
+		}
+	}
+}
+
+func TestValidateIoTTopicRuleCloudWatchAlarmStateValue(t *testing.T) {
+	validStates := []string{
+		"OK",
+		"ALARM",
+		"INSUFFICIENT_DATA",
+	}
+	for _, v := range validStates {
+		_, errors := validateIoTTopicRuleCloudWatchAlarmStateValue(v, "state_value")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid CloudWatch alarm state: %q", v, errors)
+		}
+	}
+
+	invalidStates := []string{
+		"ok",
+		"OFF",
+	}
+	for _, v := range invalidStates {
+		_, errors := validateIoTTopicRuleCloudWatchAlarmStateValue(v, "state_value")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid CloudWatch alarm state", v)
+		}
+	}
+}
+
+func TestValidateIoTTopicRuleElasticSearchEndpoint(t *testing.T) {
+	validEndpoints := []string{
+		"https://search-foo.us-east-1.es.amazonaws.com",
+		"http://example.com",
+	}
+	for _, v := range validEndpoints {
+		_, errors := validateIoTTopicRuleElasticSearchEndpoint(v, "endpoint")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid endpoint: %q", v, errors)
+		}
+	}
+
+	invalidEndpoints := []string{
+		"search-foo.us-east-1.es.amazonaws.com",
+		"ftp://example.com",
+	}
+	for _, v := range invalidEndpoints {
+		_, errors := validateIoTTopicRuleElasticSearchEndpoint(v, "endpoint")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid endpoint", v)
+		}
+	}
+}
+
+func TestValidateIoTTopicRuleFirehoseSeparator(t *testing.T) {
+	validSeparators := []string{
+		",",
+		"\t",
+		"\n",
+		"\r\n",
+	}
+	for _, v := range validSeparators {
+		_, errors := validateIoTTopicRuleFirehoseSeparator(v, "separator")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid separator: %q", v, errors)
+		}
+	}
+
+	invalidSeparators := []string{
+		";",
+		"\\n",
+	}
+	for _, v := range invalidSeparators {
+		_, errors := validateIoTTopicRuleFirehoseSeparator(v, "separator")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid separator", v)
+		}
+	}
+}
+
+func TestValidateAmazonSideAsn(t *testing.T) {
+	validAsns := []string{
+		"64512",
+		"64513",
+		"65533",
+		"65534",
+		"4200000000",
+		"4200000001",
+		"4294967293",
+		"4294967294",
+	}
+	for _, v := range validAsns {
+		_, errors := validateAmazonSideAsn(v, "amazon_side_asn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid ASN: %q", v, errors)
+		}
+	}
+
+	invalidAsns := []string{
+		"1",
+		"ABCDEFG",
+		"",
+		"7224",
+		"9059",
+		"64511",
+		"65535",
+		"4199999999",
+		"4294967295",
+		"9999999999",
+	}
+	for _, v := range invalidAsns {
+		_, errors := validateAmazonSideAsn(v, "amazon_side_asn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid ASN", v)
+		}
+	}
+}